@@ -2,6 +2,7 @@ package wavix
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/wavix/sdk-go/utils"
 )
@@ -79,6 +80,7 @@ type AccountInvoicesPaginatedResponse struct {
 type AccountTransactionsParams struct {
 	utils.OptionalDateParams
 	utils.PaginationParams
+	Type *TransactionType `url:"type,omitempty"`
 }
 
 type AccountInvoicesParams struct {
@@ -87,9 +89,9 @@ type AccountInvoicesParams struct {
 
 type AccountTransactionsItem struct {
 	Id           int             `json:"id"`
-	Amount       string          `json:"amount"`
-	BalanceAfter string          `json:"balance_after"`
-	Date         string          `json:"date"`
+	Amount       utils.Money     `json:"amount"`
+	BalanceAfter utils.Money     `json:"balance_after"`
+	Date         utils.Timestamp `json:"date"`
 	Details      string          `json:"details"`
 	ShowInvoice  bool            `json:"show_invoice"`
 	Status       string          `json:"status"`
@@ -99,10 +101,10 @@ type AccountTransactionsItem struct {
 type DownloadInvoiceItem []byte
 
 type AccountInvoiceItem struct {
-	Id       int    `json:"id"`
-	Amount   string `json:"amount"`
-	FromDate string `json:"from_date"`
-	ToDate   string `json:"to_date"`
+	Id       int             `json:"id"`
+	Amount   utils.Money     `json:"amount"`
+	FromDate utils.Timestamp `json:"from_date"`
+	ToDate   utils.Timestamp `json:"to_date"`
 }
 
 func (s *BillingService) GetAccountTransactions(params AccountTransactionsParams) (*AccountTransactionsPaginatedResponse, *utils.HttpErrorResponse) {
@@ -113,6 +115,7 @@ func (s *BillingService) GetAccountTransactions(params AccountTransactionsParams
 		return nil, &utils.HttpErrorResponse{Message: err.Error()}
 	}
 
+	params.OptionalDateParams = params.OptionalDateParams.WithDefaultLocation(s.httpConfig.LocationResolver)
 	url := utils.BuildUrlWithQueryString("/v1/billing/transactions", params)
 
 	return utils.Get[AccountTransactionsPaginatedResponse](*s.httpConfig, url, AccountTransactionsPaginatedResponse{})
@@ -124,6 +127,23 @@ func (s *BillingService) GetAccountInvoices(params AccountInvoicesParams) (*Acco
 	return utils.Get[AccountInvoicesPaginatedResponse](*s.httpConfig, url, AccountInvoicesPaginatedResponse{})
 }
 
+func newInvoicePager(billing BillingServiceInterface, params AccountInvoicesParams) *utils.Pager[AccountInvoiceItem] {
+	return utils.NewPager(params.Page, func(page int) ([]AccountInvoiceItem, utils.Pagination, *utils.HttpErrorResponse) {
+		params.Page = page
+		response, err := billing.GetAccountInvoices(params)
+
+		if err != nil {
+			return nil, utils.Pagination{}, err
+		}
+
+		return response.Items, response.Pagination, nil
+	})
+}
+
+func invoiceWithin(invoice AccountInvoiceItem, from time.Time, to time.Time) bool {
+	return (from.IsZero() || !invoice.FromDate.Time.Before(from)) && (to.IsZero() || invoice.ToDate.Time.Before(to))
+}
+
 func (s *BillingService) DownloadInvoiceById(id int) ([]byte, *utils.HttpErrorResponse) {
 	url := fmt.Sprintf("/v1/billing/invoices/%d", id)
 
@@ -0,0 +1,156 @@
+package wavix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+type InvoiceDownloadResult struct {
+	Invoice AccountInvoiceItem
+	Path    string
+	Skipped bool
+	Info    *utils.DownloadInfo
+	Err     error
+}
+
+type InvoiceDownloadServiceInterface interface {
+	DownloadInvoiceTo(ctx context.Context, id int, w io.Writer) (*utils.DownloadInfo, *utils.HttpErrorResponse)
+	DownloadInvoiceToFile(ctx context.Context, id int, filePath string) (*utils.DownloadInfo, *utils.HttpErrorResponse)
+	DownloadInvoices(ctx context.Context, dir string, dateRange utils.DateRange) ([]InvoiceDownloadResult, *utils.HttpErrorResponse)
+}
+
+func DownloadInvoiceTo(ctx context.Context, billing BillingServiceInterface, id int, w io.Writer) (*utils.DownloadInfo, *utils.HttpErrorResponse) {
+	if downloader, ok := billing.(InvoiceDownloadServiceInterface); ok {
+		return downloader.DownloadInvoiceTo(ctx, id, w)
+	}
+
+	return nil, unsupportedOperationError("DownloadInvoiceTo")
+}
+
+func DownloadInvoiceToFile(ctx context.Context, billing BillingServiceInterface, id int, filePath string) (*utils.DownloadInfo, *utils.HttpErrorResponse) {
+	if downloader, ok := billing.(InvoiceDownloadServiceInterface); ok {
+		return downloader.DownloadInvoiceToFile(ctx, id, filePath)
+	}
+
+	return nil, unsupportedOperationError("DownloadInvoiceToFile")
+}
+
+func DownloadInvoices(ctx context.Context, billing BillingServiceInterface, dir string, dateRange utils.DateRange) ([]InvoiceDownloadResult, *utils.HttpErrorResponse) {
+	if downloader, ok := billing.(InvoiceDownloadServiceInterface); ok {
+		return downloader.DownloadInvoices(ctx, dir, dateRange)
+	}
+
+	return nil, unsupportedOperationError("DownloadInvoices")
+}
+
+func (s *BillingService) DownloadInvoiceTo(ctx context.Context, id int, w io.Writer) (*utils.DownloadInfo, *utils.HttpErrorResponse) {
+	return utils.DownloadTo(ctx, *s.httpConfig, fmt.Sprintf("/v1/billing/invoices/%d", id), w, 0)
+}
+
+func (s *BillingService) DownloadInvoiceToFile(ctx context.Context, id int, filePath string) (*utils.DownloadInfo, *utils.HttpErrorResponse) {
+	partPath := filePath + ".part"
+	file, err := os.OpenFile(partPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+
+	if err != nil {
+		return nil, &utils.HttpErrorResponse{Message: err.Error()}
+	}
+
+	stat, err := file.Stat()
+
+	if err != nil {
+		file.Close()
+		return nil, &utils.HttpErrorResponse{Message: err.Error()}
+	}
+
+	info, downloadErr := utils.DownloadTo(ctx, *s.httpConfig, fmt.Sprintf("/v1/billing/invoices/%d", id), file, stat.Size())
+
+	if closeErr := file.Close(); closeErr != nil && downloadErr == nil {
+		downloadErr = &utils.HttpErrorResponse{Message: closeErr.Error()}
+	}
+
+	if downloadErr != nil {
+		return info, downloadErr
+	}
+
+	if !info.Complete() {
+		return info, &utils.HttpErrorResponse{Message: fmt.Sprintf("Invoice %d download is incomplete", id)}
+	}
+
+	if err := os.Rename(partPath, filePath); err != nil {
+		return info, &utils.HttpErrorResponse{Message: err.Error()}
+	}
+
+	return info, nil
+}
+
+func (s *BillingService) DownloadInvoices(ctx context.Context, dir string, dateRange utils.DateRange) ([]InvoiceDownloadResult, *utils.HttpErrorResponse) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, &utils.HttpErrorResponse{Message: err.Error()}
+	}
+
+	location := s.httpConfig.DefaultLocation()
+	if location == nil {
+		location = time.UTC
+	}
+
+	from, to := spendReportBounds(dateRange, location)
+
+	if dateRange.From.IsZero() {
+		from = time.Time{}
+	}
+
+	if dateRange.To.IsZero() {
+		to = time.Time{}
+	}
+
+	results := []InvoiceDownloadResult{}
+	invoices := newInvoicePager(s, AccountInvoicesParams{})
+
+	for invoices.Next() {
+		invoice := invoices.Item()
+
+		if !invoiceWithin(invoice, from, to) {
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return results, &utils.HttpErrorResponse{Message: ctx.Err().Error()}
+		}
+
+		results = append(results, s.downloadInvoiceResult(ctx, dir, invoice))
+	}
+
+	if err := invoices.Err(); err != nil {
+		return results, err
+	}
+
+	return results, nil
+}
+
+func (s *BillingService) downloadInvoiceResult(ctx context.Context, dir string, invoice AccountInvoiceItem) InvoiceDownloadResult {
+	result := InvoiceDownloadResult{Invoice: invoice, Path: filepath.Join(dir, fmt.Sprintf("invoice-%d.pdf", invoice.Id))}
+
+	if _, err := os.Stat(result.Path); err == nil {
+		result.Skipped = true
+		return result
+	} else if !errors.Is(err, os.ErrNotExist) {
+		result.Err = err
+		return result
+	}
+
+	info, err := s.DownloadInvoiceToFile(ctx, invoice.Id, result.Path)
+	result.Info = info
+
+	if err != nil {
+		result.Err = err
+	}
+
+	return result
+}
@@ -0,0 +1,295 @@
+package wavix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+type BalanceThreshold struct {
+	Name       string
+	Balance    *utils.Money
+	TimeToZero time.Duration
+}
+
+type BalanceStatus struct {
+	Balance    utils.Money   `json:"balance"`
+	BurnRate   utils.Money   `json:"burn_rate_per_hour"`
+	TimeToZero time.Duration `json:"time_to_zero"`
+	CheckedAt  time.Time     `json:"checked_at"`
+}
+
+func (s BalanceStatus) IsBurning() bool {
+	return s.BurnRate.Sign() > 0
+}
+
+type BalanceAlert struct {
+	Threshold BalanceThreshold `json:"-"`
+	Name      string           `json:"threshold"`
+	Recovered bool             `json:"recovered"`
+	Status    BalanceStatus    `json:"status"`
+}
+
+func (a BalanceAlert) Message() string {
+	if a.Recovered {
+		return fmt.Sprintf("Wavix balance recovered above %q: %s", a.Name, a.Status.Balance)
+	}
+
+	if a.Status.IsBurning() {
+		return fmt.Sprintf("Wavix balance low (%s): %s left, about %s to zero", a.Name, a.Status.Balance, a.Status.TimeToZero.Round(time.Minute))
+	}
+
+	return fmt.Sprintf("Wavix balance low (%s): %s left", a.Name, a.Status.Balance)
+}
+
+type BalanceMonitorOptions struct {
+	Thresholds   []BalanceThreshold
+	PollInterval time.Duration
+	BurnWindow   time.Duration
+	OnAlert      func(alert BalanceAlert)
+	OnRecover    func(alert BalanceAlert)
+	OnError      func(err error)
+	Sms          SmsServiceInterface
+	SmsTemplate  *SendMessagePayload
+	WebhookUrl   string
+}
+
+type BalanceMonitor struct {
+	profile ProfileServiceInterface
+	billing BillingServiceInterface
+	options BalanceMonitorOptions
+	mu      sync.Mutex
+	active  map[string]bool
+	last    *BalanceStatus
+}
+
+func NewBalanceMonitor(profile ProfileServiceInterface, billing BillingServiceInterface, options BalanceMonitorOptions) (*BalanceMonitor, error) {
+	if len(options.Thresholds) == 0 {
+		return nil, errors.New("at least one balance threshold is required")
+	}
+
+	names := map[string]bool{}
+
+	for index := range options.Thresholds {
+		threshold := &options.Thresholds[index]
+
+		if threshold.Balance == nil && threshold.TimeToZero <= 0 {
+			return nil, fmt.Errorf("balance threshold %d has neither a balance nor a time to zero", index)
+		}
+
+		if threshold.Name == "" {
+			threshold.Name = fmt.Sprintf("threshold-%d", index+1)
+		}
+
+		if names[threshold.Name] {
+			return nil, fmt.Errorf("duplicate balance threshold %q", threshold.Name)
+		}
+
+		names[threshold.Name] = true
+	}
+
+	if options.SmsTemplate != nil && options.Sms == nil {
+		return nil, errors.New("an SMS service is required to send SMS alerts")
+	}
+
+	if options.PollInterval <= 0 {
+		options.PollInterval = 5 * time.Minute
+	}
+
+	if options.BurnWindow <= 0 {
+		options.BurnWindow = 24 * time.Hour
+	}
+
+	return &BalanceMonitor{profile: profile, billing: billing, options: options, active: map[string]bool{}}, nil
+}
+
+func (m *BalanceMonitor) Status() *BalanceStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.last
+}
+
+func (m *BalanceMonitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.options.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := m.Check(ctx); err != nil && m.options.OnError != nil {
+			m.options.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *BalanceMonitor) Check(ctx context.Context) (*BalanceStatus, error) {
+	settings, httpErr := m.profile.GetAccountSettings()
+
+	if httpErr != nil {
+		return nil, httpErr
+	}
+
+	now := time.Now()
+	burnRate, err := m.burnRate(now)
+
+	if err != nil {
+		return nil, err
+	}
+
+	status := &BalanceStatus{Balance: settings.Balance, BurnRate: burnRate, TimeToZero: balanceTimeToZero(settings.Balance, burnRate), CheckedAt: now}
+
+	m.mu.Lock()
+	m.last = status
+	alerts := []BalanceAlert{}
+
+	for _, threshold := range m.options.Thresholds {
+		crossed := balanceThresholdCrossed(threshold, *status)
+
+		if crossed != m.active[threshold.Name] {
+			m.active[threshold.Name] = crossed
+			alerts = append(alerts, BalanceAlert{Threshold: threshold, Name: threshold.Name, Recovered: !crossed, Status: *status})
+		}
+	}
+	m.mu.Unlock()
+
+	errs := []error{}
+
+	for _, alert := range alerts {
+		if err := m.notify(ctx, alert); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return status, errors.Join(errs...)
+}
+
+func balanceTimeToZero(balance utils.Money, burnRate utils.Money) time.Duration {
+	if burnRate.Sign() <= 0 {
+		return -1
+	}
+
+	hours, _ := new(big.Rat).Quo(balance.Rat(), burnRate.Rat()).Float64()
+
+	if hours > math.MaxInt64/float64(time.Hour) {
+		return -1
+	}
+
+	if hours < 0 {
+		return 0
+	}
+
+	return time.Duration(hours * float64(time.Hour))
+}
+
+func (m *BalanceMonitor) burnRate(now time.Time) (utils.Money, error) {
+	params := AccountTransactionsParams{OptionalDateParams: utils.Between(now.Add(-m.options.BurnWindow), now).Optional()}
+	transactions := NewTransactionIterator(m.billing, params, TransactionFilter{
+		Categories: []TransactionCategory{FeesTransactionCategory, UsageTransactionCategory, TaxesTransactionCategory, OtherTransactionCategory},
+	})
+
+	totals := NewTransactionTotals()
+
+	for transactions.Next() {
+		totals.Add(transactions.Transaction())
+	}
+
+	if err := transactions.Err(); err != nil {
+		return utils.Money{}, err
+	}
+
+	spend := totals.Spend()
+
+	if spend.Sign() <= 0 {
+		return utils.Money{}, nil
+	}
+
+	hours := new(big.Rat).SetFrac64(int64(m.options.BurnWindow), int64(time.Hour))
+	rate, err := utils.ParseMoney(new(big.Rat).Quo(spend.Rat(), hours).FloatString(6))
+
+	if err != nil {
+		return utils.Money{}, err
+	}
+
+	return rate, nil
+}
+
+func (m *BalanceMonitor) notify(ctx context.Context, alert BalanceAlert) error {
+	if alert.Recovered {
+		if m.options.OnRecover != nil {
+			m.options.OnRecover(alert)
+		}
+	} else if m.options.OnAlert != nil {
+		m.options.OnAlert(alert)
+	}
+
+	errs := []error{}
+
+	if m.options.SmsTemplate != nil && !alert.Recovered {
+		payload := *m.options.SmsTemplate
+		payload.MessageBody = MessageBody{Text: alert.Message()}
+
+		if _, err := m.options.Sms.SendMessage(payload); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if m.options.WebhookUrl != "" {
+		if err := postBalanceWebhook(ctx, m.options.WebhookUrl, alert); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func balanceThresholdCrossed(threshold BalanceThreshold, status BalanceStatus) bool {
+	if threshold.Balance != nil && !status.Balance.GreaterThan(*threshold.Balance) {
+		return true
+	}
+
+	return threshold.TimeToZero > 0 && status.TimeToZero >= 0 && status.TimeToZero <= threshold.TimeToZero
+}
+
+func postBalanceWebhook(ctx context.Context, url string, alert BalanceAlert) error {
+	body, err := json.Marshal(alert)
+
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	client := &http.Client{Timeout: time.Second * 10}
+	response, err := client.Do(request)
+
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode >= 300 {
+		return fmt.Errorf("balance webhook returned %s", response.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package wavix
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+func TestBalanceTimeToZero(t *testing.T) {
+	for _, test := range []struct {
+		balance  string
+		burnRate string
+		want     time.Duration
+	}{
+		{"10", "2.5", 4 * time.Hour},
+		{"-5", "1", 0},
+		{"10", "0", -1},
+		{"1000", "0.000208", -1},
+	} {
+		balance := utils.MustParseMoney(test.balance)
+		burnRate := utils.MustParseMoney(test.burnRate)
+
+		if got := balanceTimeToZero(balance, burnRate); got != test.want {
+			t.Errorf("balance %s at %s/h: got %s, want %s", test.balance, test.burnRate, got, test.want)
+		}
+	}
+}
+
+func TestBalanceThresholdIgnoresTimeToZeroWithoutEta(t *testing.T) {
+	balance := utils.MustParseMoney("1000")
+	burnRate := utils.MustParseMoney("0.000208")
+
+	if burnRate.Sign() <= 0 {
+		t.Fatalf("burn rate parsed as %s", burnRate)
+	}
+	status := BalanceStatus{Balance: balance, BurnRate: burnRate, TimeToZero: balanceTimeToZero(balance, burnRate)}
+
+	if balanceThresholdCrossed(BalanceThreshold{TimeToZero: 24 * time.Hour}, status) {
+		t.Fatal("time-to-zero threshold crossed for a balance that never runs out")
+	}
+}
@@ -0,0 +1,301 @@
+package wavix
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+type SpendReportGranularity string
+
+const (
+	DaySpendReportGranularity   SpendReportGranularity = "day"
+	WeekSpendReportGranularity  SpendReportGranularity = "week"
+	MonthSpendReportGranularity SpendReportGranularity = "month"
+)
+
+type SpendReportOptions struct {
+	Range       utils.DateRange
+	Granularity SpendReportGranularity
+	Location    *time.Location
+	Filter      TransactionFilter
+	PerPage     int
+	Tolerance   *utils.Money
+}
+
+type SpendReportBucket struct {
+	Start  time.Time          `json:"start"`
+	End    time.Time          `json:"end"`
+	Totals *TransactionTotals `json:"totals"`
+}
+
+type SpendReportReconciliation struct {
+	InvoiceCount int         `json:"invoice_count"`
+	Invoiced     utils.Money `json:"invoiced"`
+	Spend        utils.Money `json:"spend"`
+	Difference   utils.Money `json:"difference"`
+	Reconciled   bool        `json:"reconciled"`
+}
+
+type SpendReport struct {
+	From           time.Time                 `json:"from"`
+	To             time.Time                 `json:"to"`
+	Granularity    SpendReportGranularity    `json:"granularity"`
+	Buckets        []SpendReportBucket       `json:"buckets"`
+	Totals         *TransactionTotals        `json:"totals"`
+	Reconciliation SpendReportReconciliation `json:"reconciliation"`
+	toPrecision    utils.DatePrecision
+}
+
+func GenerateSpendReport(billing BillingServiceInterface, options SpendReportOptions) (*SpendReport, error) {
+	if options.Range.From.IsZero() || options.Range.To.IsZero() {
+		return nil, errors.New("spend report range is required")
+	}
+
+	if options.Granularity == "" {
+		options.Granularity = DaySpendReportGranularity
+	}
+
+	if options.Granularity != DaySpendReportGranularity && options.Granularity != WeekSpendReportGranularity && options.Granularity != MonthSpendReportGranularity {
+		return nil, fmt.Errorf("unknown spend report granularity %q", options.Granularity)
+	}
+
+	location := options.Location
+	if location == nil {
+		location = options.Range.From.Location
+	}
+	if location == nil {
+		location = time.UTC
+	}
+
+	from, to := spendReportBounds(options.Range, location)
+	report := &SpendReport{From: from, To: to, Granularity: options.Granularity, Buckets: []SpendReportBucket{}, Totals: NewTransactionTotals(), toPrecision: options.Range.To.Precision}
+	buckets := map[time.Time]*SpendReportBucket{}
+
+	params := AccountTransactionsParams{OptionalDateParams: options.Range.In(location).Optional()}
+	params.PerPage = options.PerPage
+
+	transactions := NewTransactionIterator(billing, params)
+	spend := NewTransactionTotals()
+
+	for transactions.Next() {
+		item := transactions.Transaction()
+		spend.Add(item)
+
+		if !options.Filter.Match(item) {
+			continue
+		}
+
+		start := spendReportPeriodStart(item.Date.Time.In(location), options.Granularity)
+
+		bucket, ok := buckets[start]
+		if !ok {
+			bucket = &SpendReportBucket{Start: start, End: spendReportPeriodEnd(start, options.Granularity), Totals: NewTransactionTotals()}
+			buckets[start] = bucket
+		}
+
+		bucket.Totals.Add(item)
+		report.Totals.Add(item)
+	}
+
+	if err := transactions.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, bucket := range buckets {
+		report.Buckets = append(report.Buckets, *bucket)
+	}
+
+	sort.Slice(report.Buckets, func(i, j int) bool { return report.Buckets[i].Start.Before(report.Buckets[j].Start) })
+
+	reconciliation, err := reconcileSpendReport(billing, report, spend.Spend(), options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	report.Reconciliation = *reconciliation
+
+	return report, nil
+}
+
+func (r *SpendReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
+
+func (r *SpendReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	header := []string{"period_start", "period_end"}
+
+	for _, category := range TransactionCategories {
+		header = append(header, string(category))
+	}
+
+	header = append(header, "spend", "transactions")
+
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, bucket := range r.Buckets {
+		record := []string{bucket.Start.Format("2006-01-02"), r.lastDay(bucket.End)}
+
+		for _, category := range TransactionCategories {
+			record = append(record, bucket.Totals.Categories[category].String())
+		}
+
+		record = append(record, bucket.Totals.Spend().String(), fmt.Sprint(bucket.Totals.Count))
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func (r *SpendReport) lastDay(end time.Time) string {
+	if !end.Before(r.To) {
+		end = r.To
+
+		if r.toPrecision != utils.DayDatePrecision {
+			return end.Format("2006-01-02")
+		}
+	}
+
+	return end.AddDate(0, 0, -1).Format("2006-01-02")
+}
+
+func (r *SpendReport) WriteMarkdown(w io.Writer) error {
+	out := &spendReportWriter{w: w}
+
+	out.printf("# Spend report %s – %s\n\n", r.From.Format("2006-01-02"), r.lastDay(r.To))
+	out.printf("Total spend: **%s** across %d transactions.\n\n", r.Totals.Spend(), r.Totals.Count)
+
+	out.printf("## By category\n\n| Category | Amount |\n| --- | ---: |\n")
+	for _, category := range TransactionCategories {
+		if amount, ok := r.Totals.Categories[category]; ok {
+			out.printf("| %s | %s |\n", category, amount)
+		}
+	}
+
+	out.printf("\n## By type\n\n| Type | Category | Amount |\n| --- | --- | ---: |\n")
+	types := make([]TransactionType, 0, len(r.Totals.Types))
+	for transactionType := range r.Totals.Types {
+		types = append(types, transactionType)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return r.Totals.Types[types[i]].Abs().GreaterThan(r.Totals.Types[types[j]].Abs())
+	})
+	for _, transactionType := range types {
+		out.printf("| %s | %s | %s |\n", transactionType, transactionType.Category(), r.Totals.Types[transactionType])
+	}
+
+	out.printf("\n## By %s\n\n| Period | Spend | Transactions |\n| --- | ---: | ---: |\n", r.Granularity)
+	for _, bucket := range r.Buckets {
+		out.printf("| %s | %s | %d |\n", bucket.Start.Format("2006-01-02"), bucket.Totals.Spend(), bucket.Totals.Count)
+	}
+
+	status := "reconciled"
+	if !r.Reconciliation.Reconciled {
+		status = "**not reconciled**"
+	}
+
+	out.printf("\n## Invoices\n\n%d invoices totalling %s against %s of spend (difference %s, %s).\n",
+		r.Reconciliation.InvoiceCount, r.Reconciliation.Invoiced, r.Reconciliation.Spend, r.Reconciliation.Difference, status)
+
+	return out.err
+}
+
+type spendReportWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (w *spendReportWriter) printf(format string, args ...interface{}) {
+	if w.err == nil {
+		_, w.err = fmt.Fprintf(w.w, format, args...)
+	}
+}
+
+func reconcileSpendReport(billing BillingServiceInterface, report *SpendReport, spend utils.Money, options SpendReportOptions) (*SpendReportReconciliation, error) {
+	tolerance := utils.MustParseMoney("0.01")
+	if options.Tolerance != nil {
+		tolerance = *options.Tolerance
+	}
+
+	reconciliation := &SpendReportReconciliation{Spend: spend}
+	invoices := newInvoicePager(billing, AccountInvoicesParams{PaginationParams: utils.PaginationParams{PerPage: options.PerPage}})
+
+	for invoices.Next() {
+		invoice := invoices.Item()
+
+		if !invoiceWithin(invoice, report.From, report.To) {
+			continue
+		}
+
+		reconciliation.InvoiceCount++
+		reconciliation.Invoiced = reconciliation.Invoiced.Add(invoice.Amount)
+	}
+
+	if err := invoices.Err(); err != nil {
+		return nil, err
+	}
+
+	reconciliation.Difference = reconciliation.Invoiced.Sub(reconciliation.Spend)
+	reconciliation.Reconciled = !reconciliation.Difference.Abs().GreaterThan(tolerance)
+
+	return reconciliation, nil
+}
+
+func spendReportBounds(dateRange utils.DateRange, location *time.Location) (time.Time, time.Time) {
+	from := dateRange.From.In(location)
+	to := dateRange.To.In(location)
+
+	if from.Precision == utils.DayDatePrecision {
+		year, month, day := from.Time.In(location).Date()
+		from.Time = time.Date(year, month, day, 0, 0, 0, 0, location)
+	}
+
+	if to.Precision == utils.DayDatePrecision {
+		year, month, day := to.Time.In(location).Date()
+		to.Time = time.Date(year, month, day+1, 0, 0, 0, 0, location)
+	}
+
+	return from.Time.In(location), to.Time.In(location)
+}
+
+func spendReportPeriodStart(t time.Time, granularity SpendReportGranularity) time.Time {
+	year, month, day := t.Date()
+
+	switch granularity {
+	case WeekSpendReportGranularity:
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-offset, 0, 0, 0, 0, t.Location())
+	case MonthSpendReportGranularity:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	}
+
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+func spendReportPeriodEnd(start time.Time, granularity SpendReportGranularity) time.Time {
+	switch granularity {
+	case WeekSpendReportGranularity:
+		return start.AddDate(0, 0, 7)
+	case MonthSpendReportGranularity:
+		return start.AddDate(0, 1, 0)
+	}
+
+	return start.AddDate(0, 0, 1)
+}
@@ -0,0 +1,35 @@
+package wavix
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+func TestSpendReportLastDay(t *testing.T) {
+	dayRange := utils.DateRange{From: utils.Date(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)), To: utils.Date(time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC))}
+	secondRange := utils.DateRange{From: utils.DateTime(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)), To: utils.DateTime(time.Date(2026, 9, 30, 15, 0, 0, 0, time.UTC))}
+
+	for _, test := range []struct {
+		dateRange utils.DateRange
+		end       time.Time
+		want      string
+	}{
+		{dayRange, time.Date(2026, 9, 8, 0, 0, 0, 0, time.UTC), "2026-09-07"},
+		{dayRange, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), "2026-09-30"},
+		{secondRange, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), "2026-09-30"},
+		{secondRange, time.Date(2026, 9, 8, 0, 0, 0, 0, time.UTC), "2026-09-07"},
+	} {
+		from, to := spendReportBounds(test.dateRange, time.UTC)
+		report := &SpendReport{From: from, To: to, toPrecision: test.dateRange.To.Precision}
+
+		if got := report.lastDay(test.end); got != test.want {
+			t.Errorf("lastDay(%s) with precision %d = %s, want %s", test.end, test.dateRange.To.Precision, got, test.want)
+		}
+
+		if got := report.lastDay(report.To); got != "2026-09-30" {
+			t.Errorf("report end with precision %d = %s", test.dateRange.To.Precision, got)
+		}
+	}
+}
@@ -0,0 +1,356 @@
+package wavix
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+var transactionTypeNames = map[TransactionType]string{
+	AdjustmentsTransactionType:                     "adjustments",
+	DealTransactionType:                            "deal",
+	ActivationTransactionType:                      "activation",
+	MonthTransactionType:                           "month",
+	ActivationFeeTransactionType:                   "activation_fee",
+	MonthFeeTransactionType:                        "month_fee",
+	CallTransactionType:                            "call",
+	CallFeeTransactionType:                         "call_fee",
+	CallFixFeeTransactionType:                      "call_fix_fee",
+	PaypalInTransactionType:                        "paypal_in",
+	PaypalOutTransactionType:                       "paypal_out",
+	TaxTransactionType:                             "tax",
+	WebcallTransactionType:                         "webcall",
+	SipTransactionType:                             "sip",
+	SmsTransactionType:                             "sms",
+	ChannelTransactionType:                         "channel",
+	ChannelFeeTransactionType:                      "channel_fee",
+	CallSkypeFeeTransactionType:                    "call_skype_fee",
+	CcInTransactionType:                            "cc_in",
+	PaymentFeeTransactionType:                      "payment_fee",
+	ConnectionTransactionType:                      "connection",
+	ConnectionFeeTransactionType:                   "connection_fee",
+	PortingTransactionType:                         "porting",
+	InboundSmsTransactionType:                      "inbound_sms",
+	WireTransferTransactionType:                    "wire_transfer",
+	SubscriptionTransactionType:                    "subscription",
+	SurchargeTransactionType:                       "surcharge",
+	HlrTransactionType:                             "hlr",
+	NumberValidationTransactionType:                "number_validation",
+	CallRecordingTransactionType:                   "call_recording",
+	CallRecordingStorageTransactionType:            "call_recording_storage",
+	CampaignBuilderRunTransactionType:              "campaign_builder_run",
+	VoicemailDetectionTransactionType:              "voicemail_detection",
+	SenderIdDestinationRegistrationTransactionType: "sender_id_destination_registration",
+	SenderIdDestinationFeeTransactionType:          "sender_id_destination_fee",
+	TwoFaServiceTransactionType:                    "two_fa_service",
+	IvrTransactionType:                             "ivr",
+	E911ActivationTransactionType:                  "e911_activation",
+	MmsTransactionType:                             "mms",
+	InboundMmsTransactionType:                      "inbound_mms",
+	CallTranscriptionTransactionType:               "call_transcription",
+	TendlcBrandsTransactionType:                    "tendlc_brands",
+	TendlcCampaignFeeTransactionType:               "tendlc_campaign_fee",
+	DidOrderTransactionType:                        "did_order",
+	AdjustmentsInTransactionType:                   "adjustments_in",
+}
+
+type TransactionCategory string
+
+const (
+	FeesTransactionCategory     TransactionCategory = "fees"
+	PaymentsTransactionCategory TransactionCategory = "payments"
+	UsageTransactionCategory    TransactionCategory = "usage"
+	TaxesTransactionCategory    TransactionCategory = "taxes"
+	OtherTransactionCategory    TransactionCategory = "other"
+)
+
+var TransactionCategories = []TransactionCategory{
+	FeesTransactionCategory,
+	PaymentsTransactionCategory,
+	UsageTransactionCategory,
+	TaxesTransactionCategory,
+	OtherTransactionCategory,
+}
+
+var transactionTypeCategories = map[TransactionType]TransactionCategory{
+	AdjustmentsTransactionType:                     PaymentsTransactionCategory,
+	AdjustmentsInTransactionType:                   PaymentsTransactionCategory,
+	PaypalInTransactionType:                        PaymentsTransactionCategory,
+	PaypalOutTransactionType:                       PaymentsTransactionCategory,
+	CcInTransactionType:                            PaymentsTransactionCategory,
+	WireTransferTransactionType:                    PaymentsTransactionCategory,
+	DealTransactionType:                            FeesTransactionCategory,
+	ActivationTransactionType:                      FeesTransactionCategory,
+	MonthTransactionType:                           FeesTransactionCategory,
+	ActivationFeeTransactionType:                   FeesTransactionCategory,
+	MonthFeeTransactionType:                        FeesTransactionCategory,
+	CallFeeTransactionType:                         FeesTransactionCategory,
+	CallFixFeeTransactionType:                      FeesTransactionCategory,
+	ChannelTransactionType:                         FeesTransactionCategory,
+	ChannelFeeTransactionType:                      FeesTransactionCategory,
+	CallSkypeFeeTransactionType:                    FeesTransactionCategory,
+	PaymentFeeTransactionType:                      FeesTransactionCategory,
+	ConnectionTransactionType:                      FeesTransactionCategory,
+	ConnectionFeeTransactionType:                   FeesTransactionCategory,
+	PortingTransactionType:                         FeesTransactionCategory,
+	SubscriptionTransactionType:                    FeesTransactionCategory,
+	CallRecordingStorageTransactionType:            FeesTransactionCategory,
+	SenderIdDestinationRegistrationTransactionType: FeesTransactionCategory,
+	SenderIdDestinationFeeTransactionType:          FeesTransactionCategory,
+	TwoFaServiceTransactionType:                    FeesTransactionCategory,
+	E911ActivationTransactionType:                  FeesTransactionCategory,
+	TendlcBrandsTransactionType:                    FeesTransactionCategory,
+	TendlcCampaignFeeTransactionType:               FeesTransactionCategory,
+	DidOrderTransactionType:                        FeesTransactionCategory,
+	CallTransactionType:                            UsageTransactionCategory,
+	WebcallTransactionType:                         UsageTransactionCategory,
+	SipTransactionType:                             UsageTransactionCategory,
+	SmsTransactionType:                             UsageTransactionCategory,
+	InboundSmsTransactionType:                      UsageTransactionCategory,
+	HlrTransactionType:                             UsageTransactionCategory,
+	NumberValidationTransactionType:                UsageTransactionCategory,
+	CallRecordingTransactionType:                   UsageTransactionCategory,
+	CampaignBuilderRunTransactionType:              UsageTransactionCategory,
+	VoicemailDetectionTransactionType:              UsageTransactionCategory,
+	IvrTransactionType:                             UsageTransactionCategory,
+	MmsTransactionType:                             UsageTransactionCategory,
+	InboundMmsTransactionType:                      UsageTransactionCategory,
+	CallTranscriptionTransactionType:               UsageTransactionCategory,
+	TaxTransactionType:                             TaxesTransactionCategory,
+	SurchargeTransactionType:                       TaxesTransactionCategory,
+}
+
+func TransactionTypes() []TransactionType {
+	types := make([]TransactionType, 0, len(transactionTypeNames))
+
+	for transactionType := range transactionTypeNames {
+		types = append(types, transactionType)
+	}
+
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+	return types
+}
+
+func ParseTransactionType(value string) (TransactionType, error) {
+	value = strings.TrimSpace(value)
+
+	if number, err := strconv.Atoi(value); err == nil {
+		return TransactionType(number), nil
+	}
+
+	for transactionType, name := range transactionTypeNames {
+		if strings.EqualFold(name, value) {
+			return transactionType, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown transaction type %q", value)
+}
+
+func (t TransactionType) String() string {
+	if name, ok := transactionTypeNames[t]; ok {
+		return name
+	}
+
+	return "TransactionType(" + strconv.Itoa(int(t)) + ")"
+}
+
+func (t TransactionType) IsValid() bool {
+	_, ok := transactionTypeNames[t]
+	return ok
+}
+
+func (t TransactionType) Category() TransactionCategory {
+	if category, ok := transactionTypeCategories[t]; ok {
+		return category
+	}
+
+	return OtherTransactionCategory
+}
+
+func (t TransactionType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *TransactionType) UnmarshalText(text []byte) error {
+	parsed, err := ParseTransactionType(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+func (t TransactionType) EncodeValues(key string, v *url.Values) error {
+	v.Add(key, strconv.Itoa(int(t)))
+	return nil
+}
+
+func (t TransactionType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(t))
+}
+
+func (t *TransactionType) UnmarshalJSON(data []byte) error {
+	var value interface{}
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch typed := value.(type) {
+	case float64:
+		*t = TransactionType(typed)
+		return nil
+	case string:
+		return t.UnmarshalText([]byte(typed))
+	}
+
+	return fmt.Errorf("invalid transaction type %s", data)
+}
+
+type TransactionFilter struct {
+	Types      []TransactionType
+	Categories []TransactionCategory
+}
+
+func (f TransactionFilter) IsEmpty() bool {
+	return len(f.Types) == 0 && len(f.Categories) == 0
+}
+
+func (f TransactionFilter) Match(item AccountTransactionsItem) bool {
+	if f.IsEmpty() {
+		return true
+	}
+
+	for _, transactionType := range f.Types {
+		if item.Type == transactionType {
+			return true
+		}
+	}
+
+	category := item.Type.Category()
+
+	for _, filterCategory := range f.Categories {
+		if category == filterCategory {
+			return true
+		}
+	}
+
+	return false
+}
+
+func FilterTransactions(items []AccountTransactionsItem, filter TransactionFilter) []AccountTransactionsItem {
+	filtered := []AccountTransactionsItem{}
+
+	for _, item := range items {
+		if filter.Match(item) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return filtered
+}
+
+type TransactionIterator struct {
+	pager  *utils.Pager[AccountTransactionsItem]
+	filter TransactionFilter
+}
+
+func NewTransactionIterator(service BillingServiceInterface, params AccountTransactionsParams, filters ...TransactionFilter) *TransactionIterator {
+	filter := TransactionFilter{}
+
+	for _, f := range filters {
+		filter.Types = append(filter.Types, f.Types...)
+		filter.Categories = append(filter.Categories, f.Categories...)
+	}
+
+	if params.Type == nil && len(filter.Types) == 1 && len(filter.Categories) == 0 {
+		transactionType := filter.Types[0]
+		params.Type = &transactionType
+	}
+
+	pager := utils.NewPager(params.Page, func(page int) ([]AccountTransactionsItem, utils.Pagination, *utils.HttpErrorResponse) {
+		params.Page = page
+		response, err := service.GetAccountTransactions(params)
+
+		if err != nil {
+			return nil, utils.Pagination{}, err
+		}
+
+		return response.Items, response.Pagination, nil
+	})
+
+	return &TransactionIterator{pager: pager, filter: filter}
+}
+
+func (it *TransactionIterator) Next() bool {
+	for it.pager.Next() {
+		if it.filter.Match(it.pager.Item()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (it *TransactionIterator) Transaction() AccountTransactionsItem {
+	return it.pager.Item()
+}
+
+func (it *TransactionIterator) Err() error {
+	if err := it.pager.Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type TransactionTotals struct {
+	Count      int                                 `json:"count"`
+	Total      utils.Money                         `json:"total"`
+	Categories map[TransactionCategory]utils.Money `json:"categories"`
+	Types      map[TransactionType]utils.Money     `json:"types"`
+}
+
+func NewTransactionTotals() *TransactionTotals {
+	return &TransactionTotals{Categories: map[TransactionCategory]utils.Money{}, Types: map[TransactionType]utils.Money{}}
+}
+
+func (t *TransactionTotals) Add(item AccountTransactionsItem) {
+	category := item.Type.Category()
+
+	t.Count++
+	t.Total = t.Total.Add(item.Amount)
+	t.Categories[category] = t.Categories[category].Add(item.Amount)
+	t.Types[item.Type] = t.Types[item.Type].Add(item.Amount)
+}
+
+func (t *TransactionTotals) Spend() utils.Money {
+	spend := utils.Money{}
+
+	for category, amount := range t.Categories {
+		if category != PaymentsTransactionCategory {
+			spend = spend.Sub(amount)
+		}
+	}
+
+	return spend
+}
+
+func SumTransactionsByCategory(it *TransactionIterator) (*TransactionTotals, error) {
+	totals := NewTransactionTotals()
+
+	for it.Next() {
+		totals.Add(it.Transaction())
+	}
+
+	return totals, it.Err()
+}
@@ -87,3 +87,16 @@ func (s *BuyService) GetAvailableDids(countryId int, cityId int, queryParams Get
 
 	return utils.Get[GetAvailableDidsPaginatedResponse](*s.httpConfig, url, GetAvailableDidsPaginatedResponse{})
 }
+
+func newAvailableDidsPager(buy BuyServiceInterface, countryId int, cityId int, params GetAvailableDidsQueryParams) *utils.Pager[CartDidItem] {
+	return utils.NewPager(params.Page, func(page int) ([]CartDidItem, utils.Pagination, *utils.HttpErrorResponse) {
+		params.Page = page
+		response, err := buy.GetAvailableDids(countryId, cityId, params)
+
+		if err != nil {
+			return nil, utils.Pagination{}, err
+		}
+
+		return response.Items, response.Pagination, nil
+	})
+}
@@ -0,0 +1,521 @@
+package wavix
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/wavix/sdk-go/phonenumber"
+	"github.com/wavix/sdk-go/utils"
+)
+
+var usStateCodes = map[string]string{
+	"AL": "Alabama", "AK": "Alaska", "AZ": "Arizona", "AR": "Arkansas", "CA": "California",
+	"CO": "Colorado", "CT": "Connecticut", "DE": "Delaware", "DC": "District of Columbia", "FL": "Florida",
+	"GA": "Georgia", "HI": "Hawaii", "ID": "Idaho", "IL": "Illinois", "IN": "Indiana",
+	"IA": "Iowa", "KS": "Kansas", "KY": "Kentucky", "LA": "Louisiana", "ME": "Maine",
+	"MD": "Maryland", "MA": "Massachusetts", "MI": "Michigan", "MN": "Minnesota", "MS": "Mississippi",
+	"MO": "Missouri", "MT": "Montana", "NE": "Nebraska", "NV": "Nevada", "NH": "New Hampshire",
+	"NJ": "New Jersey", "NM": "New Mexico", "NY": "New York", "NC": "North Carolina", "ND": "North Dakota",
+	"OH": "Ohio", "OK": "Oklahoma", "OR": "Oregon", "PA": "Pennsylvania", "RI": "Rhode Island",
+	"SC": "South Carolina", "SD": "South Dakota", "TN": "Tennessee", "TX": "Texas", "UT": "Utah",
+	"VT": "Vermont", "VA": "Virginia", "WA": "Washington", "WV": "West Virginia", "WI": "Wisconsin",
+	"WY": "Wyoming",
+}
+
+type CatalogCity struct {
+	City
+	CountryId  int     `json:"country_id"`
+	RegionId   int     `json:"region_id,omitempty"`
+	RegionName string  `json:"region_name,omitempty"`
+	Score      float64 `json:"score"`
+}
+
+type CatalogSnapshot struct {
+	SavedAt   time.Time         `json:"saved_at"`
+	Countries []Country         `json:"countries"`
+	Regions   map[int][]Region  `json:"regions"`
+	Cities    map[string][]City `json:"cities"`
+}
+
+type Catalog struct {
+	buy       BuyServiceInterface
+	mu        sync.Mutex
+	countries []Country
+	regions   map[int][]Region
+	cities    map[string][]City
+}
+
+func NewCatalog(buy BuyServiceInterface) *Catalog {
+	return &Catalog{buy: buy, regions: map[int][]Region{}, cities: map[string][]City{}}
+}
+
+func LoadCatalog(r io.Reader, buy BuyServiceInterface) (*Catalog, error) {
+	snapshot := CatalogSnapshot{}
+
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return nil, err
+	}
+
+	catalog := NewCatalog(buy)
+	catalog.countries = snapshot.Countries
+
+	for countryId, regions := range snapshot.Regions {
+		catalog.regions[countryId] = regions
+	}
+
+	for key, cities := range snapshot.Cities {
+		catalog.cities[key] = cities
+	}
+
+	return catalog, nil
+}
+
+func LoadCatalogFile(path string, buy BuyServiceInterface) (*Catalog, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	return LoadCatalog(file, buy)
+}
+
+func (c *Catalog) Save(w io.Writer) error {
+	c.mu.Lock()
+	snapshot := CatalogSnapshot{SavedAt: time.Now().UTC(), Countries: c.countries, Regions: c.regions, Cities: c.cities}
+	data, err := json.Marshal(snapshot)
+	c.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+func (c *Catalog) SaveFile(path string) error {
+	var builder strings.Builder
+
+	if err := c.Save(&builder); err != nil {
+		return err
+	}
+
+	return writeFileAtomic(path, []byte(builder.String()))
+}
+
+func (c *Catalog) Preload(countryIds ...int) error {
+	for _, countryId := range countryIds {
+		country, err := c.Country(countryId)
+
+		if err != nil {
+			return err
+		}
+
+		if !country.HasProvincesOrStates {
+			if _, err := c.Cities(countryId, 0); err != nil {
+				return err
+			}
+			continue
+		}
+
+		regions, err := c.Regions(countryId)
+
+		if err != nil {
+			return err
+		}
+
+		for _, region := range regions {
+			if _, err := c.Cities(countryId, region.Id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Catalog) Countries() ([]Country, error) {
+	c.mu.Lock()
+	countries := c.countries
+	c.mu.Unlock()
+
+	if countries != nil {
+		return countries, nil
+	}
+
+	if c.buy == nil {
+		return nil, errors.New("catalog is offline and has no countries")
+	}
+
+	response, err := c.buy.GetCountryList()
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.countries = response.Countries
+	c.mu.Unlock()
+
+	return response.Countries, nil
+}
+
+func (c *Catalog) Country(countryId int) (*Country, error) {
+	countries, err := c.Countries()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, country := range countries {
+		if country.Id == countryId {
+			return &country, nil
+		}
+	}
+
+	return nil, fmt.Errorf("country %d not found", countryId)
+}
+
+func (c *Catalog) CountryByCode(code string) (*Country, error) {
+	countries, err := c.Countries()
+
+	if err != nil {
+		return nil, err
+	}
+
+	region, ok := phonenumber.GetRegion(strings.TrimSpace(code))
+
+	if !ok {
+		return nil, fmt.Errorf("unknown country code %q", code)
+	}
+
+	for _, minScore := range []float64{1, 0.9} {
+		for _, country := range countries {
+			if catalogScore(region.Name, country.Name) >= minScore {
+				return &country, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("country %q not found", code)
+}
+
+func (c *Catalog) FindCountry(query string) (*Country, error) {
+	if len(strings.TrimSpace(query)) == 2 {
+		if country, err := c.CountryByCode(query); err == nil {
+			return country, nil
+		}
+	}
+
+	countries, err := c.Countries()
+
+	if err != nil {
+		return nil, err
+	}
+
+	best := -1
+	bestScore := 0.0
+
+	for index, country := range countries {
+		if score := catalogScore(query, country.Name); score > bestScore {
+			best = index
+			bestScore = score
+		}
+	}
+
+	if best < 0 {
+		return nil, fmt.Errorf("no country matches %q", query)
+	}
+
+	return &countries[best], nil
+}
+
+func (c *Catalog) Regions(countryId int) ([]Region, error) {
+	c.mu.Lock()
+	regions, ok := c.regions[countryId]
+	c.mu.Unlock()
+
+	if ok {
+		return regions, nil
+	}
+
+	if c.buy == nil {
+		return nil, fmt.Errorf("catalog is offline and has no regions for country %d", countryId)
+	}
+
+	response, err := c.buy.GetRegionList(countryId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.regions[countryId] = response.Regions
+	c.mu.Unlock()
+
+	return response.Regions, nil
+}
+
+func (c *Catalog) FindRegion(countryId int, query string) (*Region, error) {
+	regions, err := c.Regions(countryId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if name, ok := usStateCodes[strings.ToUpper(strings.TrimSpace(query))]; ok {
+		for _, region := range regions {
+			if catalogNormalize(region.Name) == catalogNormalize(name) {
+				return &region, nil
+			}
+		}
+	}
+
+	best := -1
+	bestScore := 0.0
+
+	for index, region := range regions {
+		if score := catalogScore(query, region.Name); score > bestScore {
+			best = index
+			bestScore = score
+		}
+	}
+
+	if best < 0 {
+		return nil, fmt.Errorf("no region matches %q", query)
+	}
+
+	return &regions[best], nil
+}
+
+func (c *Catalog) Cities(countryId int, regionId int) ([]City, error) {
+	key := catalogCitiesKey(countryId, regionId)
+
+	c.mu.Lock()
+	cities, ok := c.cities[key]
+	c.mu.Unlock()
+
+	if ok {
+		return cities, nil
+	}
+
+	if c.buy == nil {
+		return nil, fmt.Errorf("catalog is offline and has no cities for %s", key)
+	}
+
+	var response *GetCityListResponse
+	var err error
+
+	if regionId == 0 {
+		response, err = catalogCityList(c.buy.GetCountryCitiesList(countryId))
+	} else {
+		response, err = catalogCityList(c.buy.GetRegionCitiesList(countryId, regionId))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.cities[key] = response.Cities
+	c.mu.Unlock()
+
+	return response.Cities, nil
+}
+
+func (c *Catalog) CitiesByAreaCode(countryId int, areaCode int) ([]CatalogCity, error) {
+	cities, err := c.countryCities(countryId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []CatalogCity{}
+
+	for _, city := range cities {
+		if city.AreaCode == areaCode {
+			city.Score = 1
+			matches = append(matches, city)
+		}
+	}
+
+	return matches, nil
+}
+
+func (c *Catalog) countryCities(countryId int) ([]CatalogCity, error) {
+	country, err := c.Country(countryId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := []CatalogCity{}
+
+	if !country.HasProvincesOrStates {
+		cities, err := c.Cities(countryId, 0)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, city := range cities {
+			result = append(result, CatalogCity{City: city, CountryId: countryId})
+		}
+
+		return result, nil
+	}
+
+	regions, err := c.Regions(countryId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, region := range regions {
+		cities, err := c.Cities(countryId, region.Id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, city := range cities {
+			result = append(result, CatalogCity{City: city, CountryId: countryId, RegionId: region.Id, RegionName: region.Name})
+		}
+	}
+
+	return result, nil
+}
+
+func (c *Catalog) FindCities(countryId int, query string) ([]CatalogCity, error) {
+	name, regionQuery, hasRegion := strings.Cut(query, ",")
+	matches := []CatalogCity{}
+
+	if hasRegion {
+		region, err := c.FindRegion(countryId, regionQuery)
+
+		if err != nil {
+			return nil, err
+		}
+
+		cities, err := c.Cities(countryId, region.Id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, city := range cities {
+			if score := catalogScore(name, city.Name); score > 0 {
+				matches = append(matches, CatalogCity{City: city, CountryId: countryId, RegionId: region.Id, RegionName: region.Name, Score: score})
+			}
+		}
+	} else {
+		cities, err := c.countryCities(countryId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, city := range cities {
+			if city.Score = catalogScore(name, city.Name); city.Score > 0 {
+				matches = append(matches, city)
+			}
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+
+	return matches, nil
+}
+
+func catalogCityList(response *GetCityListResponse, err *utils.HttpErrorResponse) (*GetCityListResponse, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func catalogCitiesKey(countryId int, regionId int) string {
+	return fmt.Sprintf("%d/%d", countryId, regionId)
+}
+
+func catalogNormalize(value string) string {
+	var builder strings.Builder
+
+	for _, char := range strings.ToLower(value) {
+		if unicode.IsLetter(char) || unicode.IsDigit(char) {
+			builder.WriteRune(char)
+		}
+	}
+
+	return builder.String()
+}
+
+func catalogScore(query string, name string) float64 {
+	query = catalogNormalize(query)
+	name = catalogNormalize(name)
+
+	switch {
+	case query == "" || name == "":
+		return 0
+	case query == name:
+		return 1
+	case strings.HasPrefix(name, query):
+		return 0.9
+	case strings.Contains(name, query):
+		return 0.8
+	}
+
+	queryRunes := []rune(query)
+	nameRunes := []rune(name)
+	longest := len(queryRunes)
+
+	if len(nameRunes) > longest {
+		longest = len(nameRunes)
+	}
+
+	similarity := 1 - float64(catalogDistance(queryRunes, nameRunes))/float64(longest)
+
+	if similarity < 0.75 {
+		return 0
+	}
+
+	return similarity * 0.8
+}
+
+func catalogDistance(a []rune, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
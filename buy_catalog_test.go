@@ -0,0 +1,45 @@
+package wavix
+
+import "testing"
+
+func testCatalog() *Catalog {
+	catalog := NewCatalog(nil)
+	catalog.countries = []Country{{Id: 1, Name: "United States", HasProvincesOrStates: true}, {Id: 2, Name: "Germany"}}
+	catalog.regions[1] = []Region{{Id: 10, Name: "New York"}, {Id: 11, Name: "California"}}
+	catalog.cities[catalogCitiesKey(1, 10)] = []City{{Id: 100, AreaCode: 212, Name: "New York City"}}
+	catalog.cities[catalogCitiesKey(1, 11)] = []City{{Id: 101, AreaCode: 415, Name: "San Francisco"}}
+	catalog.cities[catalogCitiesKey(2, 0)] = []City{{Id: 200, AreaCode: 30, Name: "Berlin"}}
+
+	return catalog
+}
+
+func TestCatalogCitiesWalkRegions(t *testing.T) {
+	catalog := testCatalog()
+
+	cities, err := catalog.CitiesByAreaCode(1, 415)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cities) != 1 || cities[0].Id != 101 || cities[0].RegionId != 11 || cities[0].RegionName != "California" {
+		t.Fatalf("area code 415: %+v", cities)
+	}
+
+	cities, err = catalog.FindCities(1, "new york")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cities) != 1 || cities[0].Id != 100 || cities[0].RegionId != 10 || cities[0].Score <= 0 {
+		t.Fatalf("new york: %+v", cities)
+	}
+
+	cities, err = catalog.CitiesByAreaCode(2, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cities) != 1 || cities[0].Id != 200 || cities[0].RegionId != 0 {
+		t.Fatalf("area code 30: %+v", cities)
+	}
+}
@@ -0,0 +1,298 @@
+package wavix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/wavix/sdk-go/phonenumber"
+	"github.com/wavix/sdk-go/utils"
+)
+
+var vanityKeypad = map[rune]rune{
+	'A': '2', 'B': '2', 'C': '2',
+	'D': '3', 'E': '3', 'F': '3',
+	'G': '4', 'H': '4', 'I': '4',
+	'J': '5', 'K': '5', 'L': '5',
+	'M': '6', 'N': '6', 'O': '6',
+	'P': '7', 'Q': '7', 'R': '7', 'S': '7',
+	'T': '8', 'U': '8', 'V': '8',
+	'W': '9', 'X': '9', 'Y': '9', 'Z': '9',
+}
+
+type DidSearchQuery struct {
+	CountryId       int
+	CityIds         []int
+	RegionIds       []int
+	Prefix          string
+	Vanity          string
+	Pattern         string
+	SmsEnabled      bool
+	MinChannels     int
+	MaxMonthlyFee   *utils.Money
+	NoDocsRequired  bool
+	TypeFilter      string
+	Limit           int
+	Concurrency     int
+	MaxPagesPerCity int
+}
+
+type DidSearchResult struct {
+	CartDidItem
+	CountryId int     `json:"country_id"`
+	CityId    int     `json:"city_id"`
+	Score     float64 `json:"score"`
+}
+
+type didSearchMatcher struct {
+	query   DidSearchQuery
+	prefix  string
+	vanity  string
+	pattern *regexp.Regexp
+}
+
+func SearchAvailableDids(ctx context.Context, buy BuyServiceInterface, query DidSearchQuery) ([]DidSearchResult, error) {
+	if query.CountryId == 0 {
+		return nil, errors.New("country is required")
+	}
+
+	matcher, err := newDidSearchMatcher(query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cityIds, err := didSearchCities(buy, query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Concurrency <= 0 {
+		query.Concurrency = 4
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var firstErr error
+	results := []DidSearchResult{}
+	semaphore := make(chan struct{}, query.Concurrency)
+
+	for _, cityId := range cityIds {
+		wg.Add(1)
+
+		go func(cityId int) {
+			defer wg.Done()
+
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				return
+			}
+
+			found, err := searchCityDids(ctx, buy, matcher, cityId)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+
+			results = append(results, found...)
+		}(cityId)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+
+		if cmp := results[i].MonthlyFee.Cmp(results[j].MonthlyFee); cmp != 0 {
+			return cmp < 0
+		}
+
+		if cmp := results[i].ActivationFee.Cmp(results[j].ActivationFee); cmp != 0 {
+			return cmp < 0
+		}
+
+		return results[i].Number < results[j].Number
+	})
+
+	if query.Limit > 0 && len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+
+	return results, nil
+}
+
+func VanityDigits(value string) string {
+	var builder strings.Builder
+
+	for _, char := range strings.ToUpper(value) {
+		if digit, ok := vanityKeypad[char]; ok {
+			builder.WriteRune(digit)
+		} else if char >= '0' && char <= '9' {
+			builder.WriteRune(char)
+		}
+	}
+
+	return builder.String()
+}
+
+func newDidSearchMatcher(query DidSearchQuery) (*didSearchMatcher, error) {
+	matcher := &didSearchMatcher{query: query, prefix: phoneDigits(query.Prefix), vanity: VanityDigits(query.Vanity)}
+
+	if query.Vanity != "" && matcher.vanity == "" {
+		return nil, fmt.Errorf("vanity pattern %q has no dialable characters", query.Vanity)
+	}
+
+	if query.Pattern != "" {
+		pattern, err := regexp.Compile(query.Pattern)
+
+		if err != nil {
+			return nil, err
+		}
+
+		matcher.pattern = pattern
+	}
+
+	return matcher, nil
+}
+
+func (m *didSearchMatcher) score(item CartDidItem) (float64, bool) {
+	if m.query.SmsEnabled && !item.SmsEnabled {
+		return 0, false
+	}
+
+	if m.query.MinChannels > 0 && item.Channels < m.query.MinChannels {
+		return 0, false
+	}
+
+	if m.query.MaxMonthlyFee != nil && item.MonthlyFee.GreaterThan(*m.query.MaxMonthlyFee) {
+		return 0, false
+	}
+
+	if m.query.NoDocsRequired && len(item.RequireDocs) > 0 {
+		return 0, false
+	}
+
+	digits := phoneDigits(item.Number)
+	score := 1.0
+
+	if m.prefix != "" && !strings.HasPrefix(digits, m.prefix) && !strings.HasPrefix(nationalDidDigits(digits), m.prefix) {
+		return 0, false
+	}
+
+	if m.pattern != nil && !m.pattern.MatchString(item.Number) && !m.pattern.MatchString(digits) {
+		return 0, false
+	}
+
+	if m.vanity != "" {
+		index := strings.LastIndex(digits, m.vanity)
+
+		if index < 0 {
+			return 0, false
+		}
+
+		score += float64(len(m.vanity)) / float64(len(digits))
+
+		if index+len(m.vanity) == len(digits) {
+			score += 0.5
+		}
+	}
+
+	return score, true
+}
+
+func nationalDidDigits(digits string) string {
+	if parsed, err := phonenumber.Parse("+"+digits, ""); err == nil {
+		return parsed.NationalNumber
+	}
+
+	return digits
+}
+
+func didSearchCities(buy BuyServiceInterface, query DidSearchQuery) ([]int, error) {
+	seen := map[int]bool{}
+	cityIds := []int{}
+
+	for _, cityId := range query.CityIds {
+		if !seen[cityId] {
+			seen[cityId] = true
+			cityIds = append(cityIds, cityId)
+		}
+	}
+
+	for _, regionId := range query.RegionIds {
+		response, err := buy.GetRegionCitiesList(query.CountryId, regionId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, city := range response.Cities {
+			if !seen[city.Id] {
+				seen[city.Id] = true
+				cityIds = append(cityIds, city.Id)
+			}
+		}
+	}
+
+	if len(cityIds) == 0 {
+		return nil, errors.New("at least one city or region is required")
+	}
+
+	return cityIds, nil
+}
+
+func searchCityDids(ctx context.Context, buy BuyServiceInterface, matcher *didSearchMatcher, cityId int) ([]DidSearchResult, error) {
+	results := []DidSearchResult{}
+	params := GetAvailableDidsQueryParams{TextEnabledOnly: matcher.query.SmsEnabled, TypeFilter: matcher.query.TypeFilter}
+	params.PerPage = 100
+	dids := newAvailableDidsPager(buy, matcher.query.CountryId, cityId, params).WithMaxPages(matcher.query.MaxPagesPerCity)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if !dids.Next() {
+			break
+		}
+
+		item := dids.Item()
+
+		if score, ok := matcher.score(item); ok {
+			results = append(results, DidSearchResult{CartDidItem: item, CountryId: matcher.query.CountryId, CityId: cityId, Score: score})
+		}
+	}
+
+	if err := dids.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
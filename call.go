@@ -67,11 +67,11 @@ const (
 )
 
 type Call struct {
-	Id         string `json:"uuid"`
-	From       string `json:"from"`
-	To         string `json:"to"`
-	StartedAt  string `json:"call_started"`
-	AnsweredAt string `json:"call_answered"`
+	Id         string          `json:"uuid"`
+	From       string          `json:"from"`
+	To         string          `json:"to"`
+	StartedAt  utils.Timestamp `json:"call_started"`
+	AnsweredAt utils.Timestamp `json:"call_answered"`
 }
 
 type InCallEventData interface{}
@@ -169,12 +169,12 @@ type CollectDTMFPayload struct {
 type CallEvent struct {
 	Uuid            string            `json:"uuid"`
 	EventType       EventType         `json:"event_type"`
-	EventTime       string            `json:"event_time"`
+	EventTime       utils.Timestamp   `json:"event_time"`
 	EventPayload    *CallEventPayload `json:"event_payload"`
 	From            string            `json:"from"`
 	To              string            `json:"to"`
-	CallStarted     string            `json:"call_started"`
-	CallAnswered    string            `json:"call_answered"`
+	CallStarted     utils.Timestamp   `json:"call_started"`
+	CallAnswered    utils.Timestamp   `json:"call_answered"`
 	MachineDetected bool              `json:"machine_detected"`
 	Tag             string            `json:"tag"`
 }
@@ -243,6 +243,10 @@ func (s *CallService) GetList() (*CallResponse, *utils.HttpErrorResponse) {
 }
 
 func (s *CallService) StartCall(payload StartCallPayload) (*CallEvent, *StartCallErrorResponse) {
+	if httpError := normalizePhoneFields(map[string]*string{"from": &payload.From, "to": &payload.To}); httpError != nil {
+		return nil, &StartCallErrorResponse{Success: false, Message: httpError.Message, Error: *httpError.Errors}
+	}
+
 	validate := utils.GetValidate()
 	err := validate.Struct(payload)
 
@@ -290,6 +294,10 @@ func (s *CallService) Tts(callId string, payload TtsPayload) (*utils.HttpSuccess
 }
 
 func (s *CallService) Transfer(callId string, payload TransferPayload) (*utils.HttpSuccessBasicResponse, *utils.HttpErrorResponse) {
+	if httpError := normalizePhoneFields(map[string]*string{"from": &payload.From, "to": &payload.To}); httpError != nil {
+		return nil, httpError
+	}
+
 	validate := utils.GetValidate()
 	err := validate.Struct(payload)
 
@@ -1,6 +1,9 @@
 package wavix
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/wavix/sdk-go/utils"
 )
 
@@ -10,25 +13,29 @@ type CartServiceInterface interface {
 	Checkout(ids []string) (*CheckoutResponse, *utils.HttpErrorResponse)
 }
 
+type CartRemovalServiceInterface interface {
+	RemoveDidsFromCart(ids []string) (*utils.HttpSuccessBasicResponse, *utils.HttpErrorResponse)
+}
+
 type CartService struct {
 	httpConfig *utils.HttpConfig
 }
 
 type CartDidItem struct {
-	Id               int      `json:"id"`
-	ActivationFee    string   `json:"activation_fee"`
-	Channels         int      `json:"channels"`
-	City             string   `json:"city"`
-	Country          string   `json:"country"`
-	Cnam             bool     `json:"cnam"`
-	CountryShortName string   `json:"country_short_name"`
-	FreeMin          int      `json:"free_min"`
-	MonthlyFee       string   `json:"monthly_fee"`
-	Number           string   `json:"number"`
-	PerMin           string   `json:"per_min"`
-	RequireDocs      []string `json:"require_docs"`
-	SmsEnabled       bool     `json:"sms_enabled"`
-	SmsPrice         string   `json:"sms_price"`
+	Id               int         `json:"id"`
+	ActivationFee    utils.Money `json:"activation_fee"`
+	Channels         int         `json:"channels"`
+	City             string      `json:"city"`
+	Country          string      `json:"country"`
+	Cnam             bool        `json:"cnam"`
+	CountryShortName string      `json:"country_short_name"`
+	FreeMin          int         `json:"free_min"`
+	MonthlyFee       utils.Money `json:"monthly_fee"`
+	Number           string      `json:"number"`
+	PerMin           utils.Money `json:"per_min"`
+	RequireDocs      []string    `json:"require_docs"`
+	SmsEnabled       bool        `json:"sms_enabled"`
+	SmsPrice         utils.Money `json:"sms_price"`
 }
 
 type CartContentDocType struct {
@@ -52,6 +59,23 @@ type GetCartContentResponse struct {
 
 type AddDidToCartResponse []CartDidItem
 
+type CartItemCost struct {
+	Did           CartDidItem `json:"did"`
+	ActivationFee utils.Money `json:"activation_fee"`
+	MonthlyFee    utils.Money `json:"monthly_fee"`
+	Total         utils.Money `json:"total"`
+}
+
+type CartCostPreview struct {
+	Items          []CartItemCost `json:"items"`
+	ActivationFees utils.Money    `json:"activation_fees"`
+	MonthlyFees    utils.Money    `json:"monthly_fees"`
+	Total          utils.Money    `json:"total"`
+	Balance        utils.Money    `json:"balance"`
+	Remaining      utils.Money    `json:"remaining"`
+	Sufficient     bool           `json:"sufficient"`
+}
+
 type CheckoutResponse struct {
 	Success bool `json:"success"`
 }
@@ -68,3 +92,99 @@ func (s *CartService) AddDidToCart(ids []string) (*AddDidToCartResponse, *utils.
 func (s *CartService) Checkout(ids []string) (*CheckoutResponse, *utils.HttpErrorResponse) {
 	return utils.Post[CheckoutResponse](*s.httpConfig, "/v1/buy/cart/checkout", CheckoutPayload{Ids: ids}, CheckoutResponse{})
 }
+
+func (s *CartService) RemoveDidsFromCart(ids []string) (*utils.HttpSuccessBasicResponse, *utils.HttpErrorResponse) {
+	queryStringSlice := make([]string, len(ids))
+	for index, id := range ids {
+		queryStringSlice[index] = fmt.Sprintf("ids[]=%v", id)
+	}
+	url := "/v1/buy/cart?" + strings.Join(queryStringSlice, "&")
+
+	return utils.Delete[utils.HttpSuccessBasicResponse](*s.httpConfig, url, utils.HttpSuccessBasicResponse{})
+}
+
+func RemoveDidsFromCart(cart CartServiceInterface, ids []string) (*utils.HttpSuccessBasicResponse, *utils.HttpErrorResponse) {
+	if remover, ok := cart.(CartRemovalServiceInterface); ok {
+		return remover.RemoveDidsFromCart(ids)
+	}
+
+	return nil, unsupportedOperationError("RemoveDidsFromCart")
+}
+
+func ClearCart(cart CartServiceInterface) (*utils.HttpSuccessBasicResponse, *utils.HttpErrorResponse) {
+	content, err := cart.GetCartContent()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content.Dids) == 0 {
+		return &utils.HttpSuccessBasicResponse{Success: true}, nil
+	}
+
+	ids := make([]string, len(content.Dids))
+	for index, did := range content.Dids {
+		ids[index] = fmt.Sprintf("%d", did.Id)
+	}
+
+	return RemoveDidsFromCart(cart, ids)
+}
+
+func PreviewCartCost(cart CartServiceInterface, profile ProfileServiceInterface, ids []string) (*CartCostPreview, *utils.HttpErrorResponse) {
+	content, err := cart.GetCartContent()
+
+	if err != nil {
+		return nil, err
+	}
+
+	selected := map[string]bool{}
+	for _, id := range ids {
+		selected[id] = true
+	}
+
+	preview := &CartCostPreview{Items: []CartItemCost{}}
+
+	for _, did := range content.Dids {
+		if len(ids) > 0 && !selected[fmt.Sprintf("%d", did.Id)] {
+			continue
+		}
+
+		item := CartItemCost{Did: did, ActivationFee: did.ActivationFee, MonthlyFee: did.MonthlyFee, Total: did.ActivationFee.Add(did.MonthlyFee)}
+		preview.Items = append(preview.Items, item)
+		preview.ActivationFees = preview.ActivationFees.Add(item.ActivationFee)
+		preview.MonthlyFees = preview.MonthlyFees.Add(item.MonthlyFee)
+		preview.Total = preview.Total.Add(item.Total)
+	}
+
+	if len(ids) > 0 && len(preview.Items) != len(selected) {
+		return nil, &utils.HttpErrorResponse{Message: "some of the requested numbers are not in the cart"}
+	}
+
+	settings, err := profile.GetAccountSettings()
+
+	if err != nil {
+		return nil, err
+	}
+
+	preview.Balance = settings.Balance
+	preview.Remaining = settings.Balance.Sub(preview.Total)
+	preview.Sufficient = !preview.Remaining.IsNegative()
+
+	return preview, nil
+}
+
+func CheckoutIfAffordable(cart CartServiceInterface, profile ProfileServiceInterface, ids []string) (*CheckoutResponse, *CartCostPreview, *utils.HttpErrorResponse) {
+	preview, err := PreviewCartCost(cart, profile, ids)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !preview.Sufficient {
+		return nil, preview, &utils.HttpErrorResponse{Message: fmt.Sprintf("insufficient funds: checkout costs %s, balance is %s", preview.Total, preview.Balance)}
+	}
+
+	response, err := cart.Checkout(ids)
+
+	return response, preview, err
+}
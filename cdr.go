@@ -13,16 +13,17 @@ type CdrService struct {
 }
 
 type CdrListItem struct {
-	Charge      string `json:"charge"`
-	Date        string `json:"date"`
-	Destination string `json:"destination"`
-	Disposition string `json:"disposition"`
-	Duration    int    `json:"duration"`
-	ForwardFee  string `json:"forward_fee"`
-	From        string `json:"from"`
-	PerMinute   string `json:"per_minute"`
-	To          string `json:"to"`
-	Uuid        string `json:"uuid"`
+	Charge      utils.Money     `json:"charge"`
+	Date        utils.Timestamp `json:"date"`
+	Destination string          `json:"destination"`
+	Disposition string          `json:"disposition"`
+	Duration    int             `json:"duration"`
+	ForwardFee  utils.Money     `json:"forward_fee"`
+	From        string          `json:"from"`
+	PerMinute   utils.Money     `json:"per_minute"`
+	To          string          `json:"to"`
+	Uuid        string          `json:"uuid"`
+	SipTrunk    string          `json:"sip_trunk"`
 }
 
 type GetCdrListQueryParams struct {
@@ -44,6 +45,7 @@ func (s *CdrService) GetCdrList(queryParams GetCdrListQueryParams) (*utils.Pagin
 		return nil, &utils.HttpErrorResponse{Message: err.Error()}
 	}
 
+	queryParams.RequiredDateParams = queryParams.RequiredDateParams.WithDefaultLocation(s.httpConfig.LocationResolver)
 	url := utils.BuildUrlWithQueryString("/v1/cdr", queryParams)
 
 	return utils.Get[utils.PaginationResponse[CdrListItem]](*s.httpConfig, url, utils.PaginationResponse[CdrListItem]{})
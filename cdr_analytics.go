@@ -0,0 +1,188 @@
+package wavix
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wavix/sdk-go/phonenumber"
+	"github.com/wavix/sdk-go/utils"
+)
+
+type CdrGroupBy string
+
+const (
+	NoneCdrGroupBy        CdrGroupBy = ""
+	DayCdrGroupBy         CdrGroupBy = "day"
+	HourCdrGroupBy        CdrGroupBy = "hour"
+	CountryCdrGroupBy     CdrGroupBy = "country"
+	DispositionCdrGroupBy CdrGroupBy = "disposition"
+	SipTrunkCdrGroupBy    CdrGroupBy = "sip_trunk"
+	FromCdrGroupBy        CdrGroupBy = "from"
+)
+
+type CdrStats struct {
+	Key      string      `json:"key"`
+	Calls    int         `json:"calls"`
+	Answered int         `json:"answered"`
+	Seconds  int64       `json:"seconds"`
+	Minutes  float64     `json:"minutes"`
+	Cost     utils.Money `json:"cost"`
+	ASR      float64     `json:"asr"`
+	ACD      float64     `json:"acd"`
+}
+
+func (s *CdrStats) Add(item CdrListItem) {
+	s.Calls++
+	s.Cost = s.Cost.Add(item.Charge).Add(item.ForwardFee)
+
+	if cdrAnswered(item) {
+		s.Answered++
+		s.Seconds += int64(item.Duration)
+	}
+
+	s.Minutes = float64(s.Seconds) / 60
+	s.ASR = 0
+	s.ACD = 0
+
+	if s.Calls > 0 {
+		s.ASR = float64(s.Answered) / float64(s.Calls)
+	}
+
+	if s.Answered > 0 {
+		s.ACD = float64(s.Seconds) / float64(s.Answered)
+	}
+}
+
+func (s CdrStats) AverageCallDuration() time.Duration {
+	return time.Duration(s.ACD * float64(time.Second))
+}
+
+type CdrAnalytics struct {
+	GroupBy CdrGroupBy `json:"group_by"`
+	Total   CdrStats   `json:"total"`
+	Groups  []CdrStats `json:"groups"`
+}
+
+type CdrAggregator struct {
+	groupBy  CdrGroupBy
+	location *time.Location
+	total    CdrStats
+	groups   map[string]*CdrStats
+}
+
+func NewCdrAggregator(groupBy CdrGroupBy, location *time.Location) (*CdrAggregator, error) {
+	switch groupBy {
+	case NoneCdrGroupBy, DayCdrGroupBy, HourCdrGroupBy, CountryCdrGroupBy, DispositionCdrGroupBy, SipTrunkCdrGroupBy, FromCdrGroupBy:
+	default:
+		return nil, fmt.Errorf("unknown CDR grouping %q", groupBy)
+	}
+
+	if location == nil {
+		location = time.UTC
+	}
+
+	return &CdrAggregator{groupBy: groupBy, location: location, groups: map[string]*CdrStats{}}, nil
+}
+
+func (a *CdrAggregator) Add(item CdrListItem) {
+	a.AddTo(a.key(item), item)
+}
+
+func (a *CdrAggregator) AddTo(key string, item CdrListItem) {
+	a.total.Add(item)
+
+	if a.groupBy == NoneCdrGroupBy {
+		return
+	}
+
+	group, ok := a.groups[key]
+	if !ok {
+		group = &CdrStats{Key: key}
+		a.groups[key] = group
+	}
+
+	group.Add(item)
+}
+
+func (a *CdrAggregator) Result() *CdrAnalytics {
+	result := &CdrAnalytics{GroupBy: a.groupBy, Total: a.total, Groups: make([]CdrStats, 0, len(a.groups))}
+
+	for _, group := range a.groups {
+		result.Groups = append(result.Groups, *group)
+	}
+
+	sort.Slice(result.Groups, func(i, j int) bool {
+		if a.groupBy == DayCdrGroupBy || a.groupBy == HourCdrGroupBy {
+			return result.Groups[i].Key < result.Groups[j].Key
+		}
+
+		if result.Groups[i].Calls != result.Groups[j].Calls {
+			return result.Groups[i].Calls > result.Groups[j].Calls
+		}
+
+		return result.Groups[i].Key < result.Groups[j].Key
+	})
+
+	return result
+}
+
+func (a *CdrAggregator) key(item CdrListItem) string {
+	switch a.groupBy {
+	case DayCdrGroupBy:
+		return item.Date.Time.In(a.location).Format("2006-01-02")
+	case HourCdrGroupBy:
+		return item.Date.Time.In(a.location).Format("2006-01-02T15")
+	case CountryCdrGroupBy:
+		return cdrCountry(item)
+	case DispositionCdrGroupBy:
+		return strings.ToLower(item.Disposition)
+	case SipTrunkCdrGroupBy:
+		return item.SipTrunk
+	case FromCdrGroupBy:
+		return phoneDigits(item.From)
+	}
+
+	return ""
+}
+
+func AggregateCdrs(records *CdrIterator, groupBy CdrGroupBy, location *time.Location) (*CdrAnalytics, error) {
+	aggregator, err := NewCdrAggregator(groupBy, location)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for records.Next() {
+		aggregator.Add(records.Cdr())
+	}
+
+	if err := records.Err(); err != nil {
+		return nil, err
+	}
+
+	return aggregator.Result(), nil
+}
+
+func cdrAnswered(item CdrListItem) bool {
+	if item.Disposition != "" {
+		return strings.EqualFold(item.Disposition, "answered")
+	}
+
+	return item.Duration > 0
+}
+
+func cdrCountry(item CdrListItem) string {
+	if digits := phoneDigits(item.To); digits != "" {
+		if parsed, err := phonenumber.Parse("+"+digits, ""); err == nil && parsed.Region != "" {
+			return parsed.Region
+		}
+	}
+
+	if item.Destination != "" {
+		return item.Destination
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,108 @@
+package wavix
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+func testCdr(date string, from string, to string, disposition string, duration int, charge string) CdrListItem {
+	parsed, _ := time.Parse(time.RFC3339, date)
+
+	return CdrListItem{
+		Date:        utils.Timestamp{Time: parsed},
+		From:        from,
+		To:          to,
+		Disposition: disposition,
+		Duration:    duration,
+		Charge:      utils.MustParseMoney(charge),
+	}
+}
+
+func TestCdrStatsAsrAndAcd(t *testing.T) {
+	stats := CdrStats{}
+
+	for _, item := range []CdrListItem{
+		testCdr("2026-09-01T10:00:00Z", "15550001111", "442071234567", "answered", 60, "0.10"),
+		testCdr("2026-09-01T10:05:00Z", "15550001111", "442071234567", "answered", 120, "0.20"),
+		testCdr("2026-09-01T10:10:00Z", "15550001111", "442071234567", "busy", 0, "0"),
+		testCdr("2026-09-01T10:15:00Z", "15550001111", "442071234567", "no answer", 30, "0"),
+	} {
+		stats.Add(item)
+	}
+
+	if stats.Calls != 4 || stats.Answered != 2 || stats.Seconds != 180 {
+		t.Fatalf("got %+v", stats)
+	}
+
+	if stats.ASR != 0.5 || stats.ACD != 90 || stats.Minutes != 3 {
+		t.Fatalf("ASR %v, ACD %v, minutes %v", stats.ASR, stats.ACD, stats.Minutes)
+	}
+
+	if stats.AverageCallDuration() != 90*time.Second {
+		t.Fatalf("average call duration %v", stats.AverageCallDuration())
+	}
+
+	if stats.Cost.String() != utils.MustParseMoney("0.30").String() {
+		t.Fatalf("cost %s", stats.Cost)
+	}
+
+	empty := CdrStats{}
+	empty.Add(testCdr("2026-09-01T10:00:00Z", "1", "2", "failed", 0, "0"))
+
+	if empty.ASR != 0 || empty.ACD != 0 {
+		t.Fatalf("unanswered stats %+v", empty)
+	}
+}
+
+func TestCdrAggregatorGroups(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("timezone data unavailable")
+	}
+
+	items := []CdrListItem{
+		testCdr("2026-09-01T03:00:00Z", "+1 555 000 1111", "+44 20 7123 4567", "answered", 60, "0.10"),
+		testCdr("2026-09-01T15:00:00Z", "1-555-000-1111", "4420 7123 4567", "answered", 60, "0.10"),
+		testCdr("2026-09-02T15:00:00Z", "15550002222", "33142685300", "busy", 0, "0"),
+	}
+
+	tests := []struct {
+		groupBy CdrGroupBy
+		keys    []string
+		calls   []int
+	}{
+		{DayCdrGroupBy, []string{"2026-08-31", "2026-09-01", "2026-09-02"}, []int{1, 1, 1}},
+		{CountryCdrGroupBy, []string{"GB", "FR"}, []int{2, 1}},
+		{FromCdrGroupBy, []string{"15550001111", "15550002222"}, []int{2, 1}},
+		{DispositionCdrGroupBy, []string{"answered", "busy"}, []int{2, 1}},
+	}
+
+	for _, test := range tests {
+		aggregator, err := NewCdrAggregator(test.groupBy, newYork)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, item := range items {
+			aggregator.Add(item)
+		}
+
+		result := aggregator.Result()
+
+		if result.Total.Calls != len(items) || len(result.Groups) != len(test.keys) {
+			t.Fatalf("%s: got %+v", test.groupBy, result)
+		}
+
+		for index, group := range result.Groups {
+			if group.Key != test.keys[index] || group.Calls != test.calls[index] {
+				t.Errorf("%s group %d: got %s/%d, want %s/%d", test.groupBy, index, group.Key, group.Calls, test.keys[index], test.calls[index])
+			}
+		}
+	}
+
+	if _, err := NewCdrAggregator("carrier", nil); err == nil {
+		t.Fatal("expected an error for an unknown grouping")
+	}
+}
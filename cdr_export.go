@@ -0,0 +1,697 @@
+package wavix
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/wavix/sdk-go/parquet"
+	"github.com/wavix/sdk-go/utils"
+)
+
+type CdrColumn string
+
+const (
+	UuidCdrColumn        CdrColumn = "uuid"
+	TypeCdrColumn        CdrColumn = "type"
+	DateCdrColumn        CdrColumn = "date"
+	FromCdrColumn        CdrColumn = "from"
+	ToCdrColumn          CdrColumn = "to"
+	DestinationCdrColumn CdrColumn = "destination"
+	DispositionCdrColumn CdrColumn = "disposition"
+	DurationCdrColumn    CdrColumn = "duration"
+	ChargeCdrColumn      CdrColumn = "charge"
+	PerMinuteCdrColumn   CdrColumn = "per_minute"
+	ForwardFeeCdrColumn  CdrColumn = "forward_fee"
+)
+
+var DefaultCdrColumns = []CdrColumn{
+	UuidCdrColumn,
+	TypeCdrColumn,
+	DateCdrColumn,
+	FromCdrColumn,
+	ToCdrColumn,
+	DestinationCdrColumn,
+	DispositionCdrColumn,
+	DurationCdrColumn,
+	ChargeCdrColumn,
+	PerMinuteCdrColumn,
+	ForwardFeeCdrColumn,
+}
+
+type CdrExportFormat string
+
+const (
+	CSVCdrExportFormat     CdrExportFormat = "csv"
+	JSONLCdrExportFormat   CdrExportFormat = "jsonl"
+	ParquetCdrExportFormat CdrExportFormat = "parquet"
+)
+
+type CdrExportRecord struct {
+	CdrListItem
+	Type string `json:"type"`
+}
+
+type cdrColumnSpec struct {
+	kind  parquet.Type
+	value func(record CdrExportRecord) interface{}
+}
+
+var cdrColumnSpecs = map[CdrColumn]cdrColumnSpec{
+	UuidCdrColumn:        {parquet.String, func(r CdrExportRecord) interface{} { return r.Uuid }},
+	TypeCdrColumn:        {parquet.String, func(r CdrExportRecord) interface{} { return r.Type }},
+	DateCdrColumn:        {parquet.Timestamp, func(r CdrExportRecord) interface{} { return r.Date }},
+	FromCdrColumn:        {parquet.String, func(r CdrExportRecord) interface{} { return r.From }},
+	ToCdrColumn:          {parquet.String, func(r CdrExportRecord) interface{} { return r.To }},
+	DestinationCdrColumn: {parquet.String, func(r CdrExportRecord) interface{} { return r.Destination }},
+	DispositionCdrColumn: {parquet.String, func(r CdrExportRecord) interface{} { return r.Disposition }},
+	DurationCdrColumn:    {parquet.Int64, func(r CdrExportRecord) interface{} { return r.Duration }},
+	ChargeCdrColumn:      {parquet.String, func(r CdrExportRecord) interface{} { return r.Charge }},
+	PerMinuteCdrColumn:   {parquet.String, func(r CdrExportRecord) interface{} { return r.PerMinute }},
+	ForwardFeeCdrColumn:  {parquet.String, func(r CdrExportRecord) interface{} { return r.ForwardFee }},
+}
+
+func ParseCdrColumns(value string) ([]CdrColumn, error) {
+	if strings.TrimSpace(value) == "" {
+		return DefaultCdrColumns, nil
+	}
+
+	columns := []CdrColumn{}
+
+	for _, name := range strings.Split(value, ",") {
+		column := CdrColumn(strings.TrimSpace(name))
+
+		if _, ok := cdrColumnSpecs[column]; !ok {
+			return nil, fmt.Errorf("unknown CDR column %q", column)
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns, nil
+}
+
+type CdrRecordWriter interface {
+	Write(record CdrExportRecord) error
+	Flush() error
+	Close() error
+}
+
+type gzipSegmentWriter struct {
+	w  io.Writer
+	zw *gzip.Writer
+}
+
+func (g *gzipSegmentWriter) Write(p []byte) (int, error) {
+	if g.zw == nil {
+		g.zw = gzip.NewWriter(g.w)
+	}
+
+	return g.zw.Write(p)
+}
+
+func (g *gzipSegmentWriter) finish() error {
+	if g.zw == nil {
+		return nil
+	}
+
+	err := g.zw.Close()
+	g.zw = nil
+	return err
+}
+
+type cdrTextWriter struct {
+	columns []CdrColumn
+	buffer  *bufio.Writer
+	gzip    *gzipSegmentWriter
+	csv     *csv.Writer
+	json    *json.Encoder
+	header  bool
+}
+
+func NewCdrCSVWriter(w io.Writer, columns []CdrColumn, compress bool) (CdrRecordWriter, error) {
+	writer, err := newCdrTextWriter(w, columns, compress)
+
+	if err != nil {
+		return nil, err
+	}
+
+	writer.csv = csv.NewWriter(writer.buffer)
+	writer.header = true
+
+	return writer, nil
+}
+
+func NewCdrJSONLWriter(w io.Writer, columns []CdrColumn, compress bool) (CdrRecordWriter, error) {
+	writer, err := newCdrTextWriter(w, columns, compress)
+
+	if err != nil {
+		return nil, err
+	}
+
+	writer.json = json.NewEncoder(writer.buffer)
+
+	return writer, nil
+}
+
+func newCdrTextWriter(w io.Writer, columns []CdrColumn, compress bool) (*cdrTextWriter, error) {
+	columns, err := checkCdrColumns(columns)
+
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &cdrTextWriter{columns: columns}
+
+	if compress {
+		writer.gzip = &gzipSegmentWriter{w: w}
+		writer.buffer = bufio.NewWriter(writer.gzip)
+	} else {
+		writer.buffer = bufio.NewWriter(w)
+	}
+
+	return writer, nil
+}
+
+func (w *cdrTextWriter) Write(record CdrExportRecord) error {
+	if w.json != nil {
+		row := make(map[string]interface{}, len(w.columns))
+
+		for _, column := range w.columns {
+			row[string(column)] = cdrColumnSpecs[column].value(record)
+		}
+
+		return w.json.Encode(row)
+	}
+
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	row := make([]string, len(w.columns))
+
+	for index, column := range w.columns {
+		row[index] = fmt.Sprint(cdrColumnSpecs[column].value(record))
+	}
+
+	return w.csv.Write(row)
+}
+
+func (w *cdrTextWriter) writeHeader() error {
+	if !w.header {
+		return nil
+	}
+
+	header := make([]string, len(w.columns))
+
+	for index, column := range w.columns {
+		header[index] = string(column)
+	}
+
+	w.header = false
+	return w.csv.Write(header)
+}
+
+func (w *cdrTextWriter) Flush() error {
+	if w.csv != nil {
+		if err := w.writeHeader(); err != nil {
+			return err
+		}
+
+		w.csv.Flush()
+
+		if err := w.csv.Error(); err != nil {
+			return err
+		}
+	}
+
+	if err := w.buffer.Flush(); err != nil {
+		return err
+	}
+
+	if w.gzip != nil {
+		return w.gzip.finish()
+	}
+
+	return nil
+}
+
+func (w *cdrTextWriter) Close() error {
+	return w.Flush()
+}
+
+type cdrParquetWriter struct {
+	columns []CdrColumn
+	writer  *parquet.Writer
+}
+
+func NewCdrParquetWriter(w io.Writer, columns []CdrColumn, compress bool) (CdrRecordWriter, error) {
+	columns, err := checkCdrColumns(columns)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schema := make([]parquet.Column, len(columns))
+
+	for index, column := range columns {
+		schema[index] = parquet.Column{Name: string(column), Type: cdrColumnSpecs[column].kind}
+	}
+
+	options := parquet.Options{}
+	if compress {
+		options.Codec = parquet.Gzip
+	}
+
+	writer, err := parquet.NewWriter(w, schema, options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &cdrParquetWriter{columns: columns, writer: writer}, nil
+}
+
+func (w *cdrParquetWriter) Write(record CdrExportRecord) error {
+	row := make([]interface{}, len(w.columns))
+
+	for index, column := range w.columns {
+		value := cdrColumnSpecs[column].value(record)
+
+		if timestamp, ok := value.(utils.Timestamp); ok {
+			value = timestamp.Time
+		}
+
+		row[index] = value
+	}
+
+	return w.writer.Write(row)
+}
+
+func (w *cdrParquetWriter) Flush() error {
+	return w.writer.Flush()
+}
+
+func (w *cdrParquetWriter) Close() error {
+	return w.writer.Close()
+}
+
+func NewCdrRecordWriter(w io.Writer, format CdrExportFormat, columns []CdrColumn, compress bool) (CdrRecordWriter, error) {
+	switch format {
+	case CSVCdrExportFormat, "":
+		return NewCdrCSVWriter(w, columns, compress)
+	case JSONLCdrExportFormat:
+		return NewCdrJSONLWriter(w, columns, compress)
+	case ParquetCdrExportFormat:
+		return NewCdrParquetWriter(w, columns, compress)
+	}
+
+	return nil, fmt.Errorf("unknown CDR export format %q", format)
+}
+
+type CdrExportProgress struct {
+	WindowStart time.Time
+	WindowEnd   time.Time
+	Type        string
+	Rows        int64
+}
+
+type CdrExportOptions struct {
+	Range          utils.DateRange
+	Types          []string
+	Disposition    string
+	Format         CdrExportFormat
+	Columns        []CdrColumn
+	Gzip           bool
+	Window         time.Duration
+	PerPage        int
+	CheckpointPath string
+	OnProgress     func(progress CdrExportProgress)
+}
+
+type CdrExportResult struct {
+	Rows    int64
+	Windows int
+	Files   []string
+	Resumed bool
+}
+
+type CdrExportCheckpoint struct {
+	From       time.Time       `json:"from"`
+	To         time.Time       `json:"to"`
+	Format     CdrExportFormat `json:"format"`
+	Gzip       bool            `json:"gzip"`
+	NextWindow time.Time       `json:"next_window"`
+	Offset     int64           `json:"offset"`
+	Rows       int64           `json:"rows"`
+	Files      []string        `json:"files"`
+}
+
+type cdrExportWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+func ExportCdrs(ctx context.Context, cdr CdrServiceInterface, w io.Writer, options CdrExportOptions) (*CdrExportResult, error) {
+	windows, err := cdrExportWindows(&options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := NewCdrRecordWriter(w, options.Format, options.Columns, options.Gzip)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CdrExportResult{}
+
+	for _, window := range windows {
+		rows, err := exportCdrWindow(ctx, cdr, writer, window, options, result.Rows)
+		result.Rows += rows
+
+		if err != nil {
+			return result, err
+		}
+
+		result.Windows++
+	}
+
+	return result, writer.Close()
+}
+
+func ExportCdrsToFile(ctx context.Context, cdr CdrServiceInterface, path string, options CdrExportOptions) (*CdrExportResult, error) {
+	windows, err := cdrExportWindows(&options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if options.CheckpointPath == "" {
+		options.CheckpointPath = path + ".checkpoint"
+	}
+
+	checkpoint := CdrExportCheckpoint{From: windows[0].start, To: windows[len(windows)-1].end, Format: options.Format, Gzip: options.Gzip, Files: []string{}}
+	result := &CdrExportResult{}
+
+	if saved, err := readCdrExportCheckpoint(options.CheckpointPath); err != nil {
+		return nil, err
+	} else if saved != nil {
+		if !saved.From.Equal(checkpoint.From) || !saved.To.Equal(checkpoint.To) || saved.Format != checkpoint.Format || saved.Gzip != checkpoint.Gzip {
+			return nil, fmt.Errorf("checkpoint %s belongs to a different export", options.CheckpointPath)
+		}
+
+		checkpoint = *saved
+		result.Resumed = true
+		result.Rows = checkpoint.Rows
+	}
+
+	if options.Format == ParquetCdrExportFormat {
+		err = exportCdrParquetFiles(ctx, cdr, path, windows, options, &checkpoint, result)
+	} else {
+		err = exportCdrTextFile(ctx, cdr, path, windows, options, &checkpoint, result)
+	}
+
+	result.Files = checkpoint.Files
+
+	if err != nil {
+		return result, err
+	}
+
+	return result, os.Remove(options.CheckpointPath)
+}
+
+func exportCdrTextFile(ctx context.Context, cdr CdrServiceInterface, path string, windows []cdrExportWindow, options CdrExportOptions, checkpoint *CdrExportCheckpoint, result *CdrExportResult) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	if err := file.Truncate(checkpoint.Offset); err != nil {
+		return err
+	}
+
+	if _, err := file.Seek(checkpoint.Offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	writer, err := NewCdrRecordWriter(file, options.Format, options.Columns, options.Gzip)
+
+	if err != nil {
+		return err
+	}
+
+	if text, ok := writer.(*cdrTextWriter); ok && checkpoint.Offset > 0 {
+		text.header = false
+	}
+
+	checkpoint.Files = []string{path}
+
+	for _, window := range windows {
+		if window.start.Before(checkpoint.NextWindow) {
+			continue
+		}
+
+		rows, err := exportCdrWindow(ctx, cdr, writer, window, options, result.Rows)
+		result.Rows += rows
+
+		if err != nil {
+			return err
+		}
+
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+
+		if err := file.Sync(); err != nil {
+			return err
+		}
+
+		offset, err := file.Seek(0, io.SeekCurrent)
+
+		if err != nil {
+			return err
+		}
+
+		result.Windows++
+		checkpoint.NextWindow = window.end
+		checkpoint.Offset = offset
+		checkpoint.Rows = result.Rows
+
+		if err := writeCdrExportCheckpoint(options.CheckpointPath, *checkpoint); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func exportCdrParquetFiles(ctx context.Context, cdr CdrServiceInterface, path string, windows []cdrExportWindow, options CdrExportOptions, checkpoint *CdrExportCheckpoint, result *CdrExportResult) error {
+	extension := filepath.Ext(path)
+	base := strings.TrimSuffix(path, extension)
+
+	if extension == "" {
+		extension = ".parquet"
+	}
+
+	for _, window := range windows {
+		if window.start.Before(checkpoint.NextWindow) {
+			continue
+		}
+
+		windowPath := fmt.Sprintf("%s-%s%s", base, window.start.Format("20060102T150405"), extension)
+		file, err := os.Create(windowPath)
+
+		if err != nil {
+			return err
+		}
+
+		writer, err := NewCdrParquetWriter(file, options.Columns, options.Gzip)
+
+		if err != nil {
+			file.Close()
+			return err
+		}
+
+		rows, err := exportCdrWindow(ctx, cdr, writer, window, options, result.Rows)
+		result.Rows += rows
+
+		if err == nil {
+			err = writer.Close()
+		}
+
+		if err == nil {
+			err = file.Sync()
+		}
+
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			return err
+		}
+
+		result.Windows++
+		checkpoint.NextWindow = window.end
+		checkpoint.Rows = result.Rows
+		checkpoint.Files = append(checkpoint.Files, windowPath)
+
+		if err := writeCdrExportCheckpoint(options.CheckpointPath, *checkpoint); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func exportCdrWindow(ctx context.Context, cdr CdrServiceInterface, writer CdrRecordWriter, window cdrExportWindow, options CdrExportOptions, total int64) (int64, error) {
+	rows := int64(0)
+
+	for _, cdrType := range options.Types {
+		params := GetCdrListQueryParams{
+			Type:               cdrType,
+			Disposition:        options.Disposition,
+			RequiredDateParams: cdrWindowDays(window.start, window.end),
+		}
+		params.PerPage = options.PerPage
+		records := NewCdrIterator(cdr, params)
+
+		for records.Next() {
+			if err := ctx.Err(); err != nil {
+				return rows, err
+			}
+
+			item := records.Cdr()
+			item.Date = item.Date.WithDefaultLocation(window.start.Location())
+
+			if item.Date.Time.Before(window.start) || !item.Date.Time.Before(window.end) {
+				continue
+			}
+
+			if err := writer.Write(CdrExportRecord{CdrListItem: item, Type: cdrType}); err != nil {
+				return rows, err
+			}
+
+			rows++
+		}
+
+		if err := records.Err(); err != nil {
+			return rows, err
+		}
+
+		if options.OnProgress != nil {
+			options.OnProgress(CdrExportProgress{WindowStart: window.start, WindowEnd: window.end, Type: cdrType, Rows: total + rows})
+		}
+	}
+
+	return rows, nil
+}
+
+func cdrWindowDays(start time.Time, end time.Time) utils.RequiredDateParams {
+	return utils.Days(start, end.Add(-time.Nanosecond)).In(start.Location()).Required()
+}
+
+func cdrExportWindows(options *CdrExportOptions) ([]cdrExportWindow, error) {
+	if options.Range.From.IsZero() || options.Range.To.IsZero() {
+		return nil, errors.New("CDR export range is required")
+	}
+
+	if options.Format == "" {
+		options.Format = CSVCdrExportFormat
+	}
+
+	if len(options.Types) == 0 {
+		options.Types = []string{"placed", "received"}
+	}
+
+	if options.Window <= 0 {
+		options.Window = 24 * time.Hour
+	}
+
+	if options.PerPage <= 0 {
+		options.PerPage = 100
+	}
+
+	location := options.Range.From.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	from, to := spendReportBounds(options.Range, location)
+
+	if !from.Before(to) {
+		return nil, errors.New("CDR export range is empty")
+	}
+
+	windows := []cdrExportWindow{}
+
+	for start := from; start.Before(to); start = start.Add(options.Window) {
+		end := start.Add(options.Window)
+
+		if end.After(to) {
+			end = to
+		}
+
+		windows = append(windows, cdrExportWindow{start: start, end: end})
+	}
+
+	return windows, nil
+}
+
+func checkCdrColumns(columns []CdrColumn) ([]CdrColumn, error) {
+	if len(columns) == 0 {
+		return DefaultCdrColumns, nil
+	}
+
+	for _, column := range columns {
+		if _, ok := cdrColumnSpecs[column]; !ok {
+			return nil, fmt.Errorf("unknown CDR column %q", column)
+		}
+	}
+
+	return columns, nil
+}
+
+func readCdrExportCheckpoint(path string) (*CdrExportCheckpoint, error) {
+	data, err := os.ReadFile(path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := &CdrExportCheckpoint{}
+
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint %s: %w", path, err)
+	}
+
+	return checkpoint, nil
+}
+
+func writeCdrExportCheckpoint(path string, checkpoint CdrExportCheckpoint) error {
+	data, err := json.Marshal(checkpoint)
+
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(path, data)
+}
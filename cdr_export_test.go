@@ -0,0 +1,73 @@
+package wavix
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+type fakeCdrService struct {
+	items []CdrListItem
+}
+
+func (f *fakeCdrService) GetCdrList(queryParams GetCdrListQueryParams) (*utils.PaginationResponse[CdrListItem], *utils.HttpErrorResponse) {
+	return &utils.PaginationResponse[CdrListItem]{Items: f.items, Pagination: utils.Pagination{CurrentPage: 1, TotalPages: 1, Total: len(f.items)}}, nil
+}
+
+func TestExportCdrsWritesHeaderWithoutRows(t *testing.T) {
+	var out bytes.Buffer
+	day := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	result, err := ExportCdrs(context.Background(), &fakeCdrService{}, &out, CdrExportOptions{
+		Range:   utils.Days(day, day),
+		Types:   []string{"placed"},
+		Columns: []CdrColumn{UuidCdrColumn, DateCdrColumn},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Rows != 0 || out.String() != "uuid,date\n" {
+		t.Fatalf("rows %d, output %q", result.Rows, out.String())
+	}
+}
+
+func TestExportCdrsReadsLocalTimestampsInRangeLocation(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	items := []CdrListItem{}
+
+	for index, value := range []string{"2026-09-01 01:00:00", "2026-09-02 01:00:00", "2026-09-01T12:00:00Z"} {
+		date, err := utils.ParseTimestamp(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		items = append(items, CdrListItem{Uuid: string(rune('a' + index)), Date: date})
+	}
+
+	var out bytes.Buffer
+	day := time.Date(2026, 9, 1, 0, 0, 0, 0, newYork)
+
+	result, err := ExportCdrs(context.Background(), &fakeCdrService{items: items}, &out, CdrExportOptions{
+		Range:   utils.Days(day, day),
+		Types:   []string{"placed"},
+		Columns: []CdrColumn{UuidCdrColumn},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Rows != 2 || strings.Join(strings.Fields(out.String()), " ") != "uuid a c" {
+		t.Fatalf("rows %d, output %q", result.Rows, out.String())
+	}
+}
@@ -0,0 +1,38 @@
+package wavix
+
+import (
+	"github.com/wavix/sdk-go/utils"
+)
+
+type CdrIterator struct {
+	pager *utils.Pager[CdrListItem]
+}
+
+func NewCdrIterator(service CdrServiceInterface, params GetCdrListQueryParams) *CdrIterator {
+	return &CdrIterator{pager: utils.NewPager(params.Page, func(page int) ([]CdrListItem, utils.Pagination, *utils.HttpErrorResponse) {
+		params.Page = page
+		response, err := service.GetCdrList(params)
+
+		if err != nil {
+			return nil, utils.Pagination{}, err
+		}
+
+		return response.Items, response.Pagination, nil
+	})}
+}
+
+func (it *CdrIterator) Next() bool {
+	return it.pager.Next()
+}
+
+func (it *CdrIterator) Cdr() CdrListItem {
+	return it.pager.Item()
+}
+
+func (it *CdrIterator) Err() error {
+	if err := it.pager.Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,320 @@
+package wavix
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+type ReconciliationMatchType string
+
+const (
+	UuidReconciliationMatchType   ReconciliationMatchType = "uuid"
+	NumberReconciliationMatchType ReconciliationMatchType = "number"
+	AmountReconciliationMatchType ReconciliationMatchType = "amount"
+)
+
+var DefaultReconciliationTransactionTypes = []TransactionType{CallTransactionType, WebcallTransactionType, SipTransactionType}
+
+type ReconciliationOptions struct {
+	Range            utils.DateRange
+	CdrTypes         []string
+	TransactionTypes []TransactionType
+	TimeTolerance    time.Duration
+	AmountTolerance  *utils.Money
+	PerPage          int
+}
+
+type CdrTransactionMatch struct {
+	Cdr         CdrListItem             `json:"cdr"`
+	Transaction AccountTransactionsItem `json:"transaction"`
+	MatchedBy   ReconciliationMatchType `json:"matched_by"`
+	Delta       utils.Money             `json:"delta"`
+}
+
+type ReconciliationReport struct {
+	From                  utils.DateParam           `json:"from"`
+	To                    utils.DateParam           `json:"to"`
+	CdrCount              int                       `json:"cdr_count"`
+	TransactionCount      int                       `json:"transaction_count"`
+	CdrCharges            utils.Money               `json:"cdr_charges"`
+	TransactionCharges    utils.Money               `json:"transaction_charges"`
+	Difference            utils.Money               `json:"difference"`
+	Matched               []CdrTransactionMatch     `json:"matched"`
+	AmountMismatches      []CdrTransactionMatch     `json:"amount_mismatches"`
+	UnmatchedCdrs         []CdrListItem             `json:"unmatched_cdrs"`
+	UnmatchedTransactions []AccountTransactionsItem `json:"unmatched_transactions"`
+}
+
+func (r *ReconciliationReport) HasDiscrepancies() bool {
+	return len(r.AmountMismatches) > 0 || len(r.UnmatchedCdrs) > 0 || len(r.UnmatchedTransactions) > 0
+}
+
+func ReconcileCdrTransactions(cdr CdrServiceInterface, billing BillingServiceInterface, options ReconciliationOptions) (*ReconciliationReport, error) {
+	if options.Range.From.IsZero() || options.Range.To.IsZero() {
+		return nil, errors.New("reconciliation range is required")
+	}
+
+	if len(options.CdrTypes) == 0 {
+		options.CdrTypes = []string{"placed", "received"}
+	}
+
+	if len(options.TransactionTypes) == 0 {
+		options.TransactionTypes = DefaultReconciliationTransactionTypes
+	}
+
+	if options.TimeTolerance <= 0 {
+		options.TimeTolerance = 2 * time.Minute
+	}
+
+	tolerance := utils.MustParseMoney("0.0001")
+	if options.AmountTolerance != nil {
+		tolerance = *options.AmountTolerance
+	}
+
+	report := &ReconciliationReport{
+		From:                  options.Range.From,
+		To:                    options.Range.To,
+		Matched:               []CdrTransactionMatch{},
+		AmountMismatches:      []CdrTransactionMatch{},
+		UnmatchedCdrs:         []CdrListItem{},
+		UnmatchedTransactions: []AccountTransactionsItem{},
+	}
+
+	cdrs := []CdrListItem{}
+
+	for _, cdrType := range options.CdrTypes {
+		params := GetCdrListQueryParams{Type: cdrType, RequiredDateParams: options.Range.Required()}
+		params.PerPage = options.PerPage
+		records := NewCdrIterator(cdr, params)
+
+		for records.Next() {
+			item := records.Cdr()
+
+			if item.Charge.IsZero() {
+				continue
+			}
+
+			cdrs = append(cdrs, item)
+			report.CdrCharges = report.CdrCharges.Add(item.Charge)
+		}
+
+		if err := records.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	transactionParams := AccountTransactionsParams{OptionalDateParams: options.Range.Optional()}
+	transactionParams.PerPage = options.PerPage
+	transactions := NewTransactionIterator(billing, transactionParams, TransactionFilter{Types: options.TransactionTypes})
+	pending := []AccountTransactionsItem{}
+
+	for transactions.Next() {
+		item := transactions.Transaction()
+		pending = append(pending, item)
+		report.TransactionCharges = report.TransactionCharges.Add(item.Amount.Abs())
+	}
+
+	if err := transactions.Err(); err != nil {
+		return nil, err
+	}
+
+	report.CdrCount = len(cdrs)
+	report.TransactionCount = len(pending)
+	report.Difference = report.TransactionCharges.Sub(report.CdrCharges)
+
+	sort.SliceStable(cdrs, func(i, j int) bool { return cdrs[i].Date.Time.Before(cdrs[j].Date.Time) })
+
+	matcher := newReconciliationIndex(pending)
+	unmatched := []CdrListItem{}
+
+	for _, matchType := range []ReconciliationMatchType{UuidReconciliationMatchType, NumberReconciliationMatchType, AmountReconciliationMatchType} {
+		unmatched = unmatched[:0]
+
+		for _, item := range cdrs {
+			index := matcher.match(item, matchType, options.TimeTolerance)
+
+			if index < 0 {
+				unmatched = append(unmatched, item)
+				continue
+			}
+
+			match := CdrTransactionMatch{Cdr: item, Transaction: pending[index], MatchedBy: matchType, Delta: pending[index].Amount.Abs().Sub(item.Charge)}
+
+			if match.Delta.Abs().GreaterThan(tolerance) {
+				report.AmountMismatches = append(report.AmountMismatches, match)
+			} else {
+				report.Matched = append(report.Matched, match)
+			}
+		}
+
+		cdrs = append([]CdrListItem{}, unmatched...)
+	}
+
+	report.UnmatchedCdrs = append(report.UnmatchedCdrs, cdrs...)
+
+	for position, item := range pending {
+		if !matcher.used[position] {
+			report.UnmatchedTransactions = append(report.UnmatchedTransactions, item)
+		}
+	}
+
+	return report, nil
+}
+
+func (r *ReconciliationReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
+
+func (r *ReconciliationReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	rows := [][]string{{"kind", "cdr_uuid", "transaction_id", "date", "cdr_charge", "transaction_amount", "delta"}}
+
+	for _, match := range r.AmountMismatches {
+		rows = append(rows, []string{"amount_mismatch", match.Cdr.Uuid, strconv.Itoa(match.Transaction.Id), match.Cdr.Date.String(), match.Cdr.Charge.String(), match.Transaction.Amount.Abs().String(), match.Delta.String()})
+	}
+
+	for _, item := range r.UnmatchedCdrs {
+		rows = append(rows, []string{"unmatched_cdr", item.Uuid, "", item.Date.String(), item.Charge.String(), "", item.Charge.Neg().String()})
+	}
+
+	for _, item := range r.UnmatchedTransactions {
+		rows = append(rows, []string{"unmatched_transaction", "", strconv.Itoa(item.Id), item.Date.String(), "", item.Amount.Abs().String(), item.Amount.Abs().String()})
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return writer.Error()
+}
+
+type reconciliationIndex struct {
+	transactions []AccountTransactionsItem
+	used         []bool
+	byDate       []int
+	byToken      map[string][]int
+}
+
+func newReconciliationIndex(transactions []AccountTransactionsItem) *reconciliationIndex {
+	index := &reconciliationIndex{transactions: transactions, used: make([]bool, len(transactions)), byDate: make([]int, len(transactions)), byToken: map[string][]int{}}
+
+	for position, transaction := range transactions {
+		index.byDate[position] = position
+
+		for _, token := range strings.FieldsFunc(transaction.Details, isNotUuidChar) {
+			index.byToken[token] = append(index.byToken[token], position)
+		}
+	}
+
+	sort.SliceStable(index.byDate, func(i, j int) bool {
+		return transactions[index.byDate[i]].Date.Time.Before(transactions[index.byDate[j]].Date.Time)
+	})
+
+	return index
+}
+
+func (x *reconciliationIndex) match(item CdrListItem, matchType ReconciliationMatchType, tolerance time.Duration) int {
+	if matchType == UuidReconciliationMatchType {
+		if item.Uuid == "" {
+			return -1
+		}
+
+		for _, position := range x.byToken[item.Uuid] {
+			if !x.used[position] {
+				x.used[position] = true
+				return position
+			}
+		}
+
+		return -1
+	}
+
+	best := -1
+	bestDistance := time.Duration(-1)
+	earliest := item.Date.Time.Add(-tolerance)
+	first := sort.Search(len(x.byDate), func(i int) bool { return !x.transactions[x.byDate[i]].Date.Time.Before(earliest) })
+
+	for _, position := range x.byDate[first:] {
+		transaction := x.transactions[position]
+		distance := transaction.Date.Time.Sub(item.Date.Time)
+
+		if distance > tolerance {
+			break
+		}
+
+		if x.used[position] {
+			continue
+		}
+
+		if distance < 0 {
+			distance = -distance
+		}
+
+		switch matchType {
+		case NumberReconciliationMatchType:
+			if !reconciliationDetailsMention(transaction.Details, item) {
+				continue
+			}
+		case AmountReconciliationMatchType:
+			if !transaction.Amount.Abs().Equal(item.Charge) {
+				continue
+			}
+		}
+
+		if best < 0 || distance < bestDistance || (distance == bestDistance && position < best) {
+			best = position
+			bestDistance = distance
+		}
+	}
+
+	if best >= 0 {
+		x.used[best] = true
+	}
+
+	return best
+}
+
+func reconciliationDetailsMention(details string, item CdrListItem) bool {
+	runs := []string{}
+
+	for _, field := range strings.FieldsFunc(details, isNotPhoneChar) {
+		if run := phoneDigits(field); len(run) >= 6 {
+			runs = append(runs, run)
+		}
+	}
+
+	for _, number := range []string{item.To, item.Destination, item.From} {
+		number = phoneDigits(number)
+
+		if len(number) < 6 {
+			continue
+		}
+
+		for _, run := range runs {
+			if len(run) >= len(number)-3 && len(run) <= len(number)+3 && (strings.HasSuffix(run, number) || strings.HasSuffix(number, run)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isNotUuidChar(char rune) bool {
+	return !(char >= '0' && char <= '9' || char >= 'a' && char <= 'z' || char >= 'A' && char <= 'Z' || char == '-')
+}
+
+func isNotPhoneChar(char rune) bool {
+	return !(char >= '0' && char <= '9' || strings.ContainsRune("+-().", char))
+}
@@ -0,0 +1,62 @@
+package wavix
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+func testTransaction(id int, date string, amount string, details string) AccountTransactionsItem {
+	parsed, _ := time.Parse(time.RFC3339, date)
+
+	return AccountTransactionsItem{Id: id, Date: utils.Timestamp{Time: parsed}, Amount: utils.MustParseMoney(amount), Details: details}
+}
+
+func TestReconciliationDetailsMention(t *testing.T) {
+	item := testCdr("2026-09-01T12:00:00Z", "+441234567890", "+12125550100", "answered", 60, "0.01")
+
+	for details, want := range map[string]bool{
+		"Call to +12125550100":       true,
+		"Call to 2125550100, 60 sec": true,
+		"Call from 441234567890":     true,
+		"Call 9441 234567 890":       false,
+		"Call 1212 5550100 60s":      false,
+		"Ref 5550100 duration 212 s": false,
+	} {
+		if got := reconciliationDetailsMention(details, item); got != want {
+			t.Errorf("%q: got %v, want %v", details, got, want)
+		}
+	}
+}
+
+func TestReconciliationIndexMatch(t *testing.T) {
+	index := newReconciliationIndex([]AccountTransactionsItem{
+		testTransaction(1, "2026-09-01T12:05:00Z", "-0.01", "Call to +12125550100"),
+		testTransaction(2, "2026-09-01T12:01:00Z", "-0.01", "Call to +12125550100"),
+		testTransaction(3, "2026-09-01T11:59:30Z", "-0.02", "Call 0f6b1c3e-1d2a-4b5c-9e8f-7a6b5c4d3e2f"),
+	})
+
+	item := testCdr("2026-09-01T12:00:00Z", "+441234567890", "+12125550100", "answered", 60, "0.01")
+	item.Uuid = "0f6b1c3e-1d2a-4b5c-9e8f-7a6b5c4d3e2f"
+
+	if got := index.match(item, UuidReconciliationMatchType, 2*time.Minute); got != 2 {
+		t.Fatalf("uuid match: got %d, want 2", got)
+	}
+
+	if got := index.match(item, UuidReconciliationMatchType, 2*time.Minute); got != -1 {
+		t.Fatalf("uuid match reused transaction %d", got)
+	}
+
+	if got := index.match(item, NumberReconciliationMatchType, 2*time.Minute); got != 1 {
+		t.Fatalf("number match: got %d, want 1", got)
+	}
+
+	if got := index.match(item, AmountReconciliationMatchType, 2*time.Minute); got != -1 {
+		t.Fatalf("amount match outside the tolerance: got %d", got)
+	}
+
+	if got := index.match(item, AmountReconciliationMatchType, 10*time.Minute); got != 0 {
+		t.Fatalf("amount match: got %d, want 0", got)
+	}
+}
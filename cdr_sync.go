@@ -0,0 +1,524 @@
+package wavix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type CdrStore interface {
+	Put(records []CdrExportRecord) (int, error)
+	Records(from time.Time, to time.Time) ([]CdrExportRecord, error)
+	HighWaterMark(cdrType string) (time.Time, error)
+	SetHighWaterMark(cdrType string, mark time.Time) error
+}
+
+type cdrStoreState struct {
+	HighWaterMarks map[string]time.Time       `json:"high_water_marks"`
+	Records        map[string]CdrExportRecord `json:"records"`
+}
+
+func newCdrStoreState() cdrStoreState {
+	return cdrStoreState{HighWaterMarks: map[string]time.Time{}, Records: map[string]CdrExportRecord{}}
+}
+
+func (s *cdrStoreState) put(records []CdrExportRecord) int {
+	inserted := 0
+
+	for _, record := range records {
+		if _, ok := s.Records[record.Uuid]; !ok {
+			inserted++
+		}
+
+		s.Records[record.Uuid] = record
+	}
+
+	return inserted
+}
+
+func (s *cdrStoreState) between(from time.Time, to time.Time) []CdrExportRecord {
+	records := []CdrExportRecord{}
+
+	for _, record := range s.Records {
+		if (!from.IsZero() && record.Date.Time.Before(from)) || (!to.IsZero() && !record.Date.Time.Before(to)) {
+			continue
+		}
+
+		records = append(records, record)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		if !records[i].Date.Time.Equal(records[j].Date.Time) {
+			return records[i].Date.Time.Before(records[j].Date.Time)
+		}
+
+		return records[i].Uuid < records[j].Uuid
+	})
+
+	return records
+}
+
+type MemoryCdrStore struct {
+	mu    sync.RWMutex
+	state cdrStoreState
+}
+
+func NewMemoryCdrStore() *MemoryCdrStore {
+	return &MemoryCdrStore{state: newCdrStoreState()}
+}
+
+func (s *MemoryCdrStore) Put(records []CdrExportRecord) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.state.put(records), nil
+}
+
+func (s *MemoryCdrStore) Records(from time.Time, to time.Time) ([]CdrExportRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.state.between(from, to), nil
+}
+
+func (s *MemoryCdrStore) HighWaterMark(cdrType string) (time.Time, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.state.HighWaterMarks[cdrType], nil
+}
+
+func (s *MemoryCdrStore) SetHighWaterMark(cdrType string, mark time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.state.HighWaterMarks[cdrType] = mark
+	return nil
+}
+
+const fileCdrStoreCachedSegments = 8
+
+type FileCdrStore struct {
+	mu       sync.Mutex
+	dir      string
+	marks    map[string]time.Time
+	segments map[string]map[string]string
+	order    []string
+}
+
+func NewFileCdrStore(dir string) (*FileCdrStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	store := &FileCdrStore{dir: dir, marks: map[string]time.Time{}, segments: map[string]map[string]string{}}
+	data, err := os.ReadFile(filepath.Join(dir, "marks.json"))
+
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &store.marks); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s *FileCdrStore) Put(records []CdrExportRecord) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	days := map[string][]CdrExportRecord{}
+
+	for _, record := range records {
+		day := cdrSegmentDay(record.Date.Time)
+		days[day] = append(days[day], record)
+	}
+
+	inserted := 0
+
+	for day, records := range days {
+		count, err := s.appendSegment(day, records)
+		inserted += count
+
+		if err != nil {
+			return inserted, err
+		}
+	}
+
+	return inserted, nil
+}
+
+func (s *FileCdrStore) Records(from time.Time, to time.Time) ([]CdrExportRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	paths, err := filepath.Glob(filepath.Join(s.dir, "*.jsonl"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	state := newCdrStoreState()
+
+	for _, path := range paths {
+		day := strings.TrimSuffix(filepath.Base(path), ".jsonl")
+
+		if (!from.IsZero() && day < cdrSegmentDay(from)) || (!to.IsZero() && day > cdrSegmentDay(to)) {
+			continue
+		}
+
+		records, err := readCdrSegment(path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		state.put(records)
+	}
+
+	return state.between(from, to), nil
+}
+
+func (s *FileCdrStore) HighWaterMark(cdrType string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.marks[cdrType], nil
+}
+
+func (s *FileCdrStore) SetHighWaterMark(cdrType string, mark time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.marks[cdrType] = mark
+	data, err := json.Marshal(s.marks)
+
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(filepath.Join(s.dir, "marks.json"), data)
+}
+
+func (s *FileCdrStore) appendSegment(day string, records []CdrExportRecord) (int, error) {
+	path := filepath.Join(s.dir, day+".jsonl")
+	seen, err := s.segment(day, path)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var buf bytes.Buffer
+	previous := map[string]string{}
+	inserted := 0
+
+	for _, record := range records {
+		line, err := json.Marshal(record)
+
+		if err != nil {
+			return 0, err
+		}
+
+		stored, ok := seen[record.Uuid]
+
+		if ok && stored == string(line) {
+			continue
+		}
+
+		if _, saved := previous[record.Uuid]; !saved {
+			previous[record.Uuid] = stored
+		}
+
+		if !ok {
+			inserted++
+		}
+
+		buf.Write(line)
+		buf.WriteByte('\n')
+		seen[record.Uuid] = string(line)
+	}
+
+	if buf.Len() == 0 {
+		return 0, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+
+	if err == nil {
+		_, err = file.Write(buf.Bytes())
+
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}
+
+	if err != nil {
+		for uuid, stored := range previous {
+			if stored == "" {
+				delete(seen, uuid)
+			} else {
+				seen[uuid] = stored
+			}
+		}
+
+		return 0, err
+	}
+
+	return inserted, nil
+}
+
+func (s *FileCdrStore) segment(day string, path string) (map[string]string, error) {
+	if seen, ok := s.segments[day]; ok {
+		return seen, nil
+	}
+
+	records, err := readCdrSegment(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]string, len(records))
+
+	for _, record := range records {
+		line, err := json.Marshal(record)
+
+		if err != nil {
+			return nil, err
+		}
+
+		seen[record.Uuid] = string(line)
+	}
+
+	if len(s.order) >= fileCdrStoreCachedSegments {
+		delete(s.segments, s.order[0])
+		s.order = s.order[1:]
+	}
+
+	s.segments[day] = seen
+	s.order = append(s.order, day)
+
+	return seen, nil
+}
+
+func readCdrSegment(path string) ([]CdrExportRecord, error) {
+	file, err := os.Open(path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	records := []CdrExportRecord{}
+	decoder := json.NewDecoder(file)
+
+	for {
+		var record CdrExportRecord
+
+		if err := decoder.Decode(&record); err == io.EOF {
+			return records, nil
+		} else if err != nil {
+			return records, fmt.Errorf("%s: %w", path, err)
+		}
+
+		records = append(records, record)
+	}
+}
+
+func cdrSegmentDay(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
+
+const DefaultCdrSyncOverlap = 4 * time.Hour
+
+type CdrSyncOptions struct {
+	Types        []string
+	Since        time.Time
+	Overlap      time.Duration
+	Window       time.Duration
+	PollInterval time.Duration
+	PerPage      int
+	OnSync       func(CdrSyncResult)
+	OnError      func(error)
+}
+
+type CdrSyncResult struct {
+	Type     string
+	From     time.Time
+	To       time.Time
+	Fetched  int
+	Inserted int
+	Mark     time.Time
+}
+
+type CdrSyncer struct {
+	cdr     CdrServiceInterface
+	store   CdrStore
+	options CdrSyncOptions
+}
+
+func NewCdrSyncer(cdr CdrServiceInterface, store CdrStore, options CdrSyncOptions) *CdrSyncer {
+	if len(options.Types) == 0 {
+		options.Types = []string{"placed", "received"}
+	}
+
+	if options.Since.IsZero() {
+		options.Since = time.Now().Add(-24 * time.Hour)
+	}
+
+	if options.Overlap <= 0 {
+		options.Overlap = DefaultCdrSyncOverlap
+	}
+
+	if options.Window <= 0 {
+		options.Window = 24 * time.Hour
+	}
+
+	if options.PollInterval <= 0 {
+		options.PollInterval = time.Minute
+	}
+
+	return &CdrSyncer{cdr: cdr, store: store, options: options}
+}
+
+func (s *CdrSyncer) Store() CdrStore {
+	return s.store
+}
+
+func (s *CdrSyncer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.options.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Sync(ctx); err != nil && s.options.OnError != nil {
+			s.options.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *CdrSyncer) Sync(ctx context.Context) ([]CdrSyncResult, error) {
+	results := []CdrSyncResult{}
+	now := time.Now()
+
+	for _, cdrType := range s.options.Types {
+		mark, err := s.store.HighWaterMark(cdrType)
+
+		if err != nil {
+			return results, err
+		}
+
+		from := s.options.Since
+
+		if !mark.IsZero() {
+			from = mark.Add(-s.options.Overlap)
+		}
+
+		for start := from; start.Before(now); start = start.Add(s.options.Window) {
+			end := start.Add(s.options.Window)
+
+			if end.After(now) {
+				end = now
+			}
+
+			result, err := s.syncWindow(ctx, cdrType, start, end, mark)
+
+			if err != nil {
+				return results, err
+			}
+
+			mark = result.Mark
+			results = append(results, result)
+
+			if s.options.OnSync != nil {
+				s.options.OnSync(result)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func (s *CdrSyncer) syncWindow(ctx context.Context, cdrType string, start time.Time, end time.Time, mark time.Time) (CdrSyncResult, error) {
+	result := CdrSyncResult{Type: cdrType, From: start, To: end, Mark: mark}
+
+	params := GetCdrListQueryParams{
+		Type:               cdrType,
+		Disposition:        "all",
+		RequiredDateParams: cdrWindowDays(start, end),
+	}
+	params.PerPage = s.options.PerPage
+
+	seen := map[string]bool{}
+	batch := []CdrExportRecord{}
+	records := NewCdrIterator(s.cdr, params)
+
+	for records.Next() {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		item := records.Cdr()
+
+		if item.Date.Time.Before(start) || !item.Date.Time.Before(end) {
+			continue
+		}
+
+		if item.Uuid == "" || seen[item.Uuid] {
+			continue
+		}
+
+		seen[item.Uuid] = true
+		batch = append(batch, CdrExportRecord{CdrListItem: item, Type: cdrType})
+
+		if item.Date.Time.After(result.Mark) {
+			result.Mark = item.Date.Time
+		}
+	}
+
+	if err := records.Err(); err != nil {
+		return result, err
+	}
+
+	inserted, err := s.store.Put(batch)
+
+	if err != nil {
+		return result, err
+	}
+
+	result.Fetched = len(batch)
+	result.Inserted = inserted
+
+	if result.Mark.After(mark) {
+		if err := s.store.SetHighWaterMark(cdrType, result.Mark); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,99 @@
+package wavix
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+func testCdrRecord(uuid string, date time.Time) CdrExportRecord {
+	return CdrExportRecord{CdrListItem: CdrListItem{Uuid: uuid, Date: utils.Timestamp{Time: date}}, Type: "placed"}
+}
+
+func TestFileCdrStoreAppendsDailySegments(t *testing.T) {
+	dir := t.TempDir()
+	day := time.Date(2026, 9, 1, 23, 30, 0, 0, time.UTC)
+
+	store, err := NewFileCdrStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inserted, err := store.Put([]CdrExportRecord{testCdrRecord("a", day), testCdrRecord("b", day.Add(time.Hour))})
+	if err != nil || inserted != 2 {
+		t.Fatalf("inserted %d, err %v", inserted, err)
+	}
+
+	if err := store.SetHighWaterMark("placed", day.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := NewFileCdrStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inserted, err = reopened.Put([]CdrExportRecord{testCdrRecord("a", day), testCdrRecord("c", day)})
+	if err != nil || inserted != 1 {
+		t.Fatalf("inserted %d after reopening, err %v", inserted, err)
+	}
+
+	for _, name := range []string{"2026-09-01.jsonl", "2026-09-02.jsonl", "marks.json"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Fatalf("missing %s: %v", name, err)
+		}
+	}
+
+	mark, _ := reopened.HighWaterMark("placed")
+	if !mark.Equal(day.Add(time.Hour)) {
+		t.Fatalf("high-water mark %v", mark)
+	}
+
+	records, err := reopened.Records(day, day.Add(time.Minute))
+	if err != nil || len(records) != 2 || records[0].Uuid != "a" || records[1].Uuid != "c" {
+		t.Fatalf("records %v, err %v", records, err)
+	}
+
+	all, _ := reopened.Records(time.Time{}, time.Time{})
+	if len(all) != 3 {
+		t.Fatalf("got %d records, want 3", len(all))
+	}
+}
+
+func TestCdrStoresUpsertCorrectedRecords(t *testing.T) {
+	dir := t.TempDir()
+	fileStore, err := NewFileCdrStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	day := time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	for name, store := range map[string]CdrStore{"memory": NewMemoryCdrStore(), "file": fileStore} {
+		original := testCdrRecord("a", day)
+		original.Charge = utils.MustParseMoney("0.01")
+		corrected := original
+		corrected.Charge = utils.MustParseMoney("0.02")
+
+		if inserted, err := store.Put([]CdrExportRecord{original, testCdrRecord("b", day)}); err != nil || inserted != 2 {
+			t.Fatalf("%s: inserted %d, err %v", name, inserted, err)
+		}
+
+		if inserted, err := store.Put([]CdrExportRecord{corrected, testCdrRecord("b", day)}); err != nil || inserted != 0 {
+			t.Fatalf("%s: inserted %d on update, err %v", name, inserted, err)
+		}
+
+		records, err := store.Records(time.Time{}, time.Time{})
+		if err != nil || len(records) != 2 || records[0].Uuid != "a" || !records[0].Charge.Equal(corrected.Charge) {
+			t.Fatalf("%s: records %+v, err %v", name, records, err)
+		}
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "2026-09-01.jsonl"))
+	if err != nil || bytes.Count(data, []byte("\n")) != 3 {
+		t.Fatalf("segment %q, err %v", data, err)
+	}
+}
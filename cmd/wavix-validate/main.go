@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+
+	wavix "github.com/wavix/sdk-go"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+func run() (code int) {
+	appId := flag.String("appid", os.Getenv("WAVIX_APPID"), "Wavix API key (defaults to WAVIX_APPID)")
+	baseUrl := flag.String("base-url", "", "Wavix API base URL")
+	input := flag.String("in", "-", "input file with phone numbers (CSV or JSONL), - for stdin")
+	inputFormat := flag.String("in-format", "", "input format: csv or jsonl (detected from extension by default)")
+	column := flag.String("column", "phone_number", "CSV column name or index, or JSONL field holding the phone number")
+	output := flag.String("out", "-", "output file, - for stdout")
+	outputFormat := flag.String("out-format", "", "output format: csv or jsonl (detected from extension by default)")
+	columns := flag.String("columns", "", "comma-separated list of result columns to export")
+	validationType := flag.String("type", string(wavix.FormatValidationType), "validation type: format or analysis")
+	chunkSize := flag.Int("chunk-size", wavix.DefaultValidationChunkSize, "numbers per async validation request")
+	concurrency := flag.Int("concurrency", 4, "concurrent validation requests")
+	flag.Parse()
+
+	if *appId == "" {
+		return fail(fmt.Errorf("appid is required"))
+	}
+
+	resultColumns, err := wavix.ParseValidationColumns(*columns)
+	if err != nil {
+		return fail(err)
+	}
+
+	numbers, err := readNumbers(*input, detectFormat(*inputFormat, *input), *column)
+	if err != nil {
+		return fail(err)
+	}
+
+	out, closeOut, err := openOutput(*output)
+	if err != nil {
+		return fail(err)
+	}
+	defer func() {
+		if err := closeOut(); err != nil && code == 0 {
+			code = fail(err)
+		}
+	}()
+
+	var writer wavix.ValidationResultWriter
+	if detectFormat(*outputFormat, *output) == "jsonl" {
+		writer, err = wavix.NewValidationJSONLWriter(out, resultColumns)
+	} else {
+		writer, err = wavix.NewValidationCSVWriter(out, resultColumns)
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	instance := wavix.Init(wavix.ClientOptions{Appid: *appId, BaseURL: *baseUrl})
+	summary, err := wavix.RunValidationJob(ctx, instance.NumberValidation, numbers, writer, wavix.ValidationJobOptions{
+		Type: wavix.ValidationType(*validationType),
+		ValidateManyOptions: wavix.ValidateManyOptions{
+			ChunkSize:   *chunkSize,
+			Concurrency: *concurrency,
+			OnProgress: func(progress wavix.ValidateManyProgress) {
+				fmt.Fprintf(os.Stderr, "\rvalidated %d/%d", progress.Completed, progress.Total)
+			},
+		},
+	})
+	fmt.Fprintln(os.Stderr)
+
+	if summary != nil {
+		encoded, _ := json.MarshalIndent(summary, "", "  ")
+		fmt.Fprintln(os.Stderr, string(encoded))
+	}
+
+	if err != nil {
+		return fail(err)
+	}
+
+	return 0
+}
+
+func readNumbers(path string, format string, column string) ([]string, error) {
+	var reader io.Reader = os.Stdin
+
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	if format == "jsonl" {
+		return wavix.ReadNumbersJSONL(reader, column)
+	}
+
+	return wavix.ReadNumbersCSV(reader, column)
+}
+
+func openOutput(path string) (io.Writer, func() error, error) {
+	if path == "-" {
+		return os.Stdout, func() error { return nil }, nil
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return file, file.Close, nil
+}
+
+func detectFormat(format string, path string) string {
+	if format != "" {
+		return strings.ToLower(format)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonl", ".ndjson":
+		return "jsonl"
+	}
+
+	return "csv"
+}
+
+func fail(err error) int {
+	fmt.Fprintln(os.Stderr, "error:", err)
+	return 1
+}
@@ -58,8 +58,8 @@ type DidDocument struct {
 
 type DidItem struct {
 	Id                     int              `json:"id"`
-	ActivationFee          string           `json:"activation_fee"`
-	Added                  string           `json:"added"`
+	ActivationFee          utils.Money      `json:"activation_fee"`
+	Added                  utils.Timestamp  `json:"added"`
 	CallRecordingEnabled   bool             `json:"call_recording_enabled"`
 	Channels               int              `json:"channels"`
 	City                   string           `json:"city"`
@@ -69,10 +69,10 @@ type DidItem struct {
 	Destination            []DidDestination `json:"destination"`
 	Documents              []DidDocument    `json:"documents"`
 	Label                  string           `json:"label"`
-	MonthlyFee             string           `json:"monthly_fee"`
+	MonthlyFee             utils.Money      `json:"monthly_fee"`
 	Number                 string           `json:"number"`
-	PaidUntil              string           `json:"paid_until"`
-	PerMin                 string           `json:"per_min"`
+	PaidUntil              utils.Timestamp  `json:"paid_until"`
+	PerMin                 utils.Money      `json:"per_min"`
 	RequireDocs            []string         `json:"require_docs"`
 	Seconds                string           `json:"seconds"`
 	SmsEnabled             bool             `json:"sms_enabled"`
@@ -59,12 +59,20 @@ type ValidateE911AddressResponse struct {
 }
 
 func (s *E911Service) GetList(params GetE911ListQueryParams) (*utils.PaginationResponse[E911ListItem], *utils.HttpErrorResponse) {
+	if httpError := normalizePhoneFields(map[string]*string{"phone_number": &params.PhoneNumber}); httpError != nil {
+		return nil, httpError
+	}
+
 	url := utils.BuildUrlWithQueryString("/v1/e911-records", params)
 
 	return utils.Get[utils.PaginationResponse[E911ListItem]](*s.httpConfig, url, utils.PaginationResponse[E911ListItem]{})
 }
 
 func (s *E911Service) ValidateAddress(payload ValidateE911AddressPayload) (*ValidateE911AddressResponse, *utils.HttpErrorResponse) {
+	if httpError := normalizePhoneFields(map[string]*string{"phone_number": &payload.PhoneNumber}); httpError != nil {
+		return nil, httpError
+	}
+
 	validate := utils.GetValidate()
 	err := validate.Struct(payload)
 	if err != nil {
@@ -75,6 +83,10 @@ func (s *E911Service) ValidateAddress(payload ValidateE911AddressPayload) (*Vali
 }
 
 func (s *E911Service) Create(payload CreateE911Payload) (*utils.HttpSuccessBasicResponse, *utils.HttpErrorResponse) {
+	if httpError := normalizePhoneFields(map[string]*string{"phone_number": &payload.PhoneNumber}); httpError != nil {
+		return nil, httpError
+	}
+
 	validate := utils.GetValidate()
 	err := validate.Struct(payload)
 	if err != nil {
@@ -85,6 +97,10 @@ func (s *E911Service) Create(payload CreateE911Payload) (*utils.HttpSuccessBasic
 }
 
 func (s *E911Service) Delete(params DeleteE911QueryParams) (*utils.HttpSuccessBasicResponse, *utils.HttpErrorResponse) {
+	if httpError := normalizePhoneFields(map[string]*string{"phone_number": &params.PhoneNumber}); httpError != nil {
+		return nil, httpError
+	}
+
 	url := utils.BuildUrlWithQueryString("/v1/e911-records", params)
 
 	return utils.Delete[utils.HttpSuccessBasicResponse](*s.httpConfig, url, utils.HttpSuccessBasicResponse{})
@@ -26,15 +26,15 @@ type CreateShortLinkPayload struct {
 }
 
 type ShortLinkMetricListItem struct {
-	Latitude        float64 `json:"latitude"`
-	Longitude       float64 `json:"longitude"`
-	OperatingSystem string  `json:"operating_system"`
-	Browser         string  `json:"browser"`
-	Language        string  `json:"language"`
-	Phone           string  `json:"phone"`
-	UtmCampaign     string  `json:"utm_campaign"`
-	CreatedAt       string  `json:"created_at"`
-	UserId          int     `json:"user_id"`
+	Latitude        float64         `json:"latitude"`
+	Longitude       float64         `json:"longitude"`
+	OperatingSystem string          `json:"operating_system"`
+	Browser         string          `json:"browser"`
+	Language        string          `json:"language"`
+	Phone           string          `json:"phone"`
+	UtmCampaign     string          `json:"utm_campaign"`
+	CreatedAt       utils.Timestamp `json:"created_at"`
+	UserId          int             `json:"user_id"`
 }
 
 type GetShortLinksMetricsResponse struct {
@@ -46,6 +46,10 @@ type CreateShortLinkResponse struct {
 }
 
 func (s *LinkShortenerService) GetShortLinkMetrics(queryParams GetShortLinksMetricsQueryParams) (*GetShortLinksMetricsResponse, *utils.HttpErrorResponse) {
+	if httpError := normalizePhoneFields(map[string]*string{"phone": &queryParams.Phone}); httpError != nil {
+		return nil, httpError
+	}
+
 	validate := utils.GetValidate()
 	err := validate.Struct(queryParams)
 
@@ -53,12 +57,17 @@ func (s *LinkShortenerService) GetShortLinkMetrics(queryParams GetShortLinksMetr
 		return nil, &utils.HttpErrorResponse{Message: err.Error()}
 	}
 
+	queryParams.RequiredDateParams = queryParams.RequiredDateParams.WithDefaultLocation(s.httpConfig.LocationResolver)
 	url := utils.BuildUrlWithQueryString("/v1/short-links/metrics", queryParams)
 
 	return utils.Get[GetShortLinksMetricsResponse](*s.httpConfig, url, GetShortLinksMetricsResponse{})
 }
 
 func (s *LinkShortenerService) CreateShortLink(payload CreateShortLinkPayload) (*CreateShortLinkResponse, *utils.HttpErrorResponse) {
+	if httpError := normalizePhoneFields(map[string]*string{"phone": &payload.Phone}); httpError != nil {
+		return nil, httpError
+	}
+
 	validate := utils.GetValidate()
 	err := validate.Struct(payload)
 
@@ -1,6 +1,9 @@
 package wavix
 
 import (
+	"sync"
+	"time"
+
 	"github.com/wavix/sdk-go/utils"
 )
 
@@ -23,14 +26,17 @@ type Instance struct {
 }
 
 type ClientOptions struct {
-	Appid   string
-	BaseURL string
+	Appid    string
+	BaseURL  string
+	Location *time.Location
 }
 
 func Init(options ClientOptions) *Instance {
 
 	baseURL := getBaseURL(options.BaseURL)
 	httpConfig := utils.InitHttpConfig(baseURL, options.Appid)
+	profile := &ProfileService{httpConfig}
+	httpConfig.LocationResolver = profileLocationResolver(profile, options.Location)
 
 	return &Instance{
 		NumberValidation: &ValidationService{httpConfig},
@@ -39,7 +45,7 @@ func Init(options ClientOptions) *Instance {
 		Cart:             &CartService{httpConfig},
 		Buy:              &BuyService{httpConfig},
 		Cdr:              &CdrService{httpConfig},
-		Profile:          &ProfileService{httpConfig},
+		Profile:          profile,
 		SipTrunk:         &SipTrunkService{httpConfig},
 		Did:              &DidService{httpConfig},
 		E911:             &E911Service{httpConfig},
@@ -51,6 +57,32 @@ func Init(options ClientOptions) *Instance {
 	}
 }
 
+func profileLocationResolver(profile ProfileServiceInterface, location *time.Location) func() *time.Location {
+	if location != nil {
+		return func() *time.Location { return location }
+	}
+
+	var mu sync.Mutex
+	var resolved *time.Location
+
+	return func() *time.Location {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if resolved == nil {
+			if timezone, err := GetProfileTimezone(profile); err == nil {
+				resolved = timezone
+			}
+		}
+
+		return resolved
+	}
+}
+
+func unsupportedOperationError(operation string) *utils.HttpErrorResponse {
+	return &utils.HttpErrorResponse{Message: operation + " is not supported by this service implementation"}
+}
+
 func getBaseURL(baseURL string) string {
 	if baseURL == "" {
 		return "https://api.wavix.com"
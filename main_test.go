@@ -0,0 +1,51 @@
+package wavix
+
+import (
+	"testing"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+type fakeProfileService struct {
+	calls int
+	fail  bool
+}
+
+func (f *fakeProfileService) GetCustomerInfo() (*GetCustomerInfoResponse, *utils.HttpErrorResponse) {
+	f.calls++
+
+	if f.fail {
+		return nil, &utils.HttpErrorResponse{Message: "unavailable"}
+	}
+
+	return &GetCustomerInfoResponse{Timezone: "Europe/Berlin"}, nil
+}
+
+func (f *fakeProfileService) UpdateCustomerInfo(payload UpdateCustomerInfoPayload) (*UpdateCustomerInfoResponse, *utils.HttpErrorResponse) {
+	return nil, unsupportedOperationError("UpdateCustomerInfo")
+}
+
+func (f *fakeProfileService) GetAccountSettings() (*GetAccountSettingsResponse, *utils.HttpErrorResponse) {
+	return nil, unsupportedOperationError("GetAccountSettings")
+}
+
+func TestProfileLocationResolverRetriesAfterFailure(t *testing.T) {
+	profile := &fakeProfileService{fail: true}
+	resolve := profileLocationResolver(profile, nil)
+
+	if location := resolve(); location != nil {
+		t.Fatalf("got %v while the profile is unavailable", location)
+	}
+
+	profile.fail = false
+
+	if location := resolve(); location == nil || location.String() != "Europe/Berlin" {
+		t.Fatalf("got %v after the profile recovered", location)
+	}
+
+	resolve()
+
+	if profile.calls != 2 {
+		t.Fatalf("profile fetched %d times, want 2", profile.calls)
+	}
+}
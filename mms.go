@@ -0,0 +1,205 @@
+package wavix
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+const MmsMaxMediaCount = 10
+const MmsMaxTotalSize int64 = 5 * 1024 * 1024
+
+var MmsMediaSizeLimits = map[string]int64{
+	"image/jpeg":      1024 * 1024,
+	"image/png":       1024 * 1024,
+	"image/gif":       1024 * 1024,
+	"image/bmp":       1024 * 1024,
+	"audio/mpeg":      1024 * 1024,
+	"audio/mp4":       1024 * 1024,
+	"audio/amr":       1024 * 1024,
+	"audio/wav":       1024 * 1024,
+	"video/mp4":       3 * 1024 * 1024,
+	"video/3gpp":      3 * 1024 * 1024,
+	"text/plain":      256 * 1024,
+	"text/vcard":      256 * 1024,
+	"application/pdf": 1024 * 1024,
+}
+
+type MmsMediaServiceInterface interface {
+	UploadMedia(media MmsMediaPayload) (*UploadMmsMediaResponse, *utils.HttpErrorResponse)
+}
+
+type MmsMediaPayload struct {
+	Reader      io.Reader
+	FileName    string
+	ContentType string
+}
+
+type UploadMmsMediaResponse struct {
+	Url         string `json:"url"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+}
+
+type mmsMediaFile struct {
+	data        []byte
+	fileName    string
+	contentType string
+}
+
+func (m mmsMediaFile) GetFileData() utils.File {
+	return utils.File{Reader: bytes.NewReader(m.data), FileName: m.fileName, FileKey: "file"}
+}
+
+func (m mmsMediaFile) GetFormValues() url.Values {
+	return url.Values{
+		"content_type": []string{m.contentType},
+	}
+}
+
+func (s *SmsService) UploadMedia(media MmsMediaPayload) (*UploadMmsMediaResponse, *utils.HttpErrorResponse) {
+	file, err := readMmsMedia(media)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.UploadWithResult[UploadMmsMediaResponse](*s.httpConfig, "/v2/messages/media", file, UploadMmsMediaResponse{})
+}
+
+func UploadMedia(sms SmsServiceInterface, media MmsMediaPayload) (*UploadMmsMediaResponse, *utils.HttpErrorResponse) {
+	uploader, ok := sms.(MmsMediaServiceInterface)
+
+	if !ok {
+		return nil, unsupportedOperationError("UploadMedia")
+	}
+
+	return uploader.UploadMedia(media)
+}
+
+func AttachMedia(sms SmsServiceInterface, payload *SendMessagePayload, media ...MmsMediaPayload) *utils.HttpErrorResponse {
+	uploader, ok := sms.(MmsMediaServiceInterface)
+
+	if !ok {
+		return unsupportedOperationError("AttachMedia")
+	}
+
+	var urls []string
+	if payload.MessageBody.Media != nil {
+		urls = append(urls, *payload.MessageBody.Media...)
+	}
+
+	if len(urls)+len(media) > MmsMaxMediaCount {
+		return &utils.HttpErrorResponse{Message: fmt.Sprintf("MMS supports up to %d media files", MmsMaxMediaCount)}
+	}
+
+	files := make([]mmsMediaFile, len(media))
+	var totalSize int64
+
+	for index, item := range media {
+		file, err := readMmsMedia(item)
+
+		if err != nil {
+			return err
+		}
+
+		files[index] = *file
+		totalSize += int64(len(file.data))
+	}
+
+	if totalSize > MmsMaxTotalSize {
+		return &utils.HttpErrorResponse{Message: fmt.Sprintf("MMS media exceeds total size limit of %d bytes", MmsMaxTotalSize)}
+	}
+
+	for _, file := range files {
+		response, err := uploader.UploadMedia(MmsMediaPayload{Reader: bytes.NewReader(file.data), FileName: file.fileName, ContentType: file.contentType})
+
+		if err != nil {
+			return err
+		}
+
+		urls = append(urls, response.Url)
+	}
+
+	payload.MessageBody.Media = &urls
+
+	return nil
+}
+
+func readMmsMedia(media MmsMediaPayload) (*mmsMediaFile, *utils.HttpErrorResponse) {
+	if media.Reader == nil {
+		return nil, &utils.HttpErrorResponse{Message: "Media reader is required"}
+	}
+
+	if media.FileName == "" {
+		return nil, &utils.HttpErrorResponse{Message: "Media file name is required"}
+	}
+
+	var maxLimit int64
+	for _, limit := range MmsMediaSizeLimits {
+		if limit > maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	data, err := io.ReadAll(io.LimitReader(media.Reader, maxLimit+1))
+
+	if err != nil {
+		return nil, &utils.HttpErrorResponse{Message: err.Error()}
+	}
+
+	if len(data) == 0 {
+		return nil, &utils.HttpErrorResponse{Message: "Media file is empty"}
+	}
+
+	contentType := detectMmsContentType(media, data)
+	limit, ok := MmsMediaSizeLimits[contentType]
+
+	if !ok {
+		return nil, &utils.HttpErrorResponse{Message: fmt.Sprintf("Unsupported media content type %s", contentType)}
+	}
+
+	if int64(len(data)) > limit {
+		return nil, &utils.HttpErrorResponse{Message: fmt.Sprintf("Media file %s exceeds %d bytes limit for %s", media.FileName, limit, contentType)}
+	}
+
+	return &mmsMediaFile{data: data, fileName: media.FileName, contentType: contentType}, nil
+}
+
+func detectMmsContentType(media MmsMediaPayload, data []byte) string {
+	contentType := media.ContentType
+
+	if contentType == "" {
+		contentType = mime.TypeByExtension(strings.ToLower(filepath.Ext(media.FileName)))
+	}
+
+	if contentType == "" || contentType == "application/octet-stream" {
+		contentType = http.DetectContentType(data)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+
+	if err != nil {
+		return contentType
+	}
+
+	switch mediaType {
+	case "image/jpg":
+		return "image/jpeg"
+	case "audio/mp3":
+		return "audio/mpeg"
+	case "audio/wave", "audio/x-wav":
+		return "audio/wav"
+	case "text/x-vcard":
+		return "text/vcard"
+	}
+
+	return mediaType
+}
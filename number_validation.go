@@ -1,81 +1,202 @@
 package wavix
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"time"
 
 	"github.com/wavix/sdk-go/utils"
 )
 
 type NumberValidationServiceInterface interface {
-	ValidateSingle(number string, validationType string) (*NumberValidationBody, *utils.HttpErrorResponse)
-	ValidateBatch(numbers []string, validationType string) (*NumberValidationResponse, *utils.HttpErrorResponse)
-	ValidateBatchAsync(numbers []string, validationType string) (*NumberValidationAsyncResponse, *utils.HttpErrorResponse)
+	ValidateSingle(number string, validationType ValidationType) (*NumberValidationBody, *utils.HttpErrorResponse)
+	ValidateBatch(numbers []string, validationType ValidationType) (*NumberValidationResponse, *utils.HttpErrorResponse)
+	ValidateBatchAsync(numbers []string, validationType ValidationType) (*NumberValidationAsyncResponse, *utils.HttpErrorResponse)
 	GetValidationResult(uuid string) (*NumberValidationResponse, *utils.HttpErrorResponse)
 }
 
+type ValidationType string
+type NumberType string
+type NumberValidationErrorCode string
+
+const (
+	FormatValidationType   ValidationType = "format"
+	AnalysisValidationType ValidationType = "analysis"
+)
+
+const (
+	MobileNumberType            NumberType = "mobile"
+	FixedLineNumberType         NumberType = "fixed_line"
+	FixedLineOrMobileNumberType NumberType = "fixed_line_or_mobile"
+	VoipNumberType              NumberType = "voip"
+	TollFreeNumberType          NumberType = "toll_free"
+	PremiumRateNumberType       NumberType = "premium_rate"
+	SharedCostNumberType        NumberType = "shared_cost"
+	PersonalNumberType          NumberType = "personal_number"
+	PagerNumberType             NumberType = "pager"
+	UanNumberType               NumberType = "uan"
+	VoicemailNumberType         NumberType = "voicemail"
+	UnknownNumberType           NumberType = "unknown"
+)
+
+const (
+	NoneNumberValidationErrorCode               NumberValidationErrorCode = ""
+	InvalidNumberValidationErrorCode            NumberValidationErrorCode = "invalid_number"
+	InvalidFormatNumberValidationErrorCode      NumberValidationErrorCode = "invalid_format"
+	UnsupportedCountryNumberValidationErrorCode NumberValidationErrorCode = "unsupported_country"
+	LookupFailedNumberValidationErrorCode       NumberValidationErrorCode = "lookup_failed"
+)
+
+func (t ValidationType) IsValid() bool {
+	switch t {
+	case FormatValidationType, AnalysisValidationType:
+		return true
+	}
+
+	return false
+}
+
+func (t ValidationType) validate() *utils.HttpErrorResponse {
+	if !t.IsValid() {
+		return &utils.HttpErrorResponse{Message: fmt.Sprintf("Unknown validation type %q, expected one of: %s, %s", string(t), FormatValidationType, AnalysisValidationType)}
+	}
+
+	return nil
+}
+
+func (t *ValidationType) UnmarshalText(text []byte) error {
+	value := ValidationType(text)
+
+	if err := value.validate(); err != nil {
+		return err
+	}
+
+	*t = value
+	return nil
+}
+
+func (t NumberType) IsMobile() bool {
+	return t == MobileNumberType || t == FixedLineOrMobileNumberType
+}
+
 type ValidationService struct {
 	httpConfig *utils.HttpConfig
 }
 
 type NumberValidationBody struct {
-	PhoneNumber      string `json:"phone_number"`
-	Valid            bool   `json:"valid"`
-	CountryCode      string `json:"country_code"`
-	E164Format       string `json:"e164_format"`
-	NationalFormat   string `json:"national_format"`
-	Ported           bool   `json:"ported"`
-	Mcc              string `json:"mcc"`
-	Mnc              string `json:"mnc"`
-	NumberType       string `json:"number_type"`
-	CarrierName      string `json:"carrier_name"`
-	RiskyDestination bool   `json:"risky_destination"`
-	UnallocatedRange bool   `json:"unallocated_range"`
-	Reachable        bool   `json:"reachable"`
-	Roaming          bool   `json:"roaming"`
-	Timezone         string `json:"timezone"`
-	Charge           string `json:"charge"`
-	ErrorCode        string `json:"error_code"`
+	PhoneNumber      string                    `json:"phone_number"`
+	Valid            bool                      `json:"valid"`
+	CountryCode      string                    `json:"country_code"`
+	E164Format       string                    `json:"e164_format"`
+	NationalFormat   string                    `json:"national_format"`
+	Ported           bool                      `json:"ported"`
+	Mcc              string                    `json:"mcc"`
+	Mnc              string                    `json:"mnc"`
+	NumberType       NumberType                `json:"number_type"`
+	CarrierName      string                    `json:"carrier_name"`
+	RiskyDestination bool                      `json:"risky_destination"`
+	UnallocatedRange bool                      `json:"unallocated_range"`
+	Reachable        bool                      `json:"reachable"`
+	Roaming          bool                      `json:"roaming"`
+	Timezone         string                    `json:"timezone"`
+	Location         *time.Location            `json:"-"`
+	Charge           utils.Money               `json:"charge"`
+	ErrorCode        NumberValidationErrorCode `json:"error_code"`
+}
+
+func (body *NumberValidationBody) UnmarshalJSON(data []byte) error {
+	type numberValidationBody NumberValidationBody
+	var tmp numberValidationBody
+
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	*body = NumberValidationBody(tmp)
+
+	if body.Timezone != "" {
+		if location, err := utils.ParseTimezone(body.Timezone); err == nil {
+			body.Location = location
+		}
+	}
+
+	return nil
 }
 
 type NumberValidationResponse struct {
-	Status  string `json:"status"`
-	Count   int    `json:"count"`
-	Pending int    `json:"pending"`
-	Items   []NumberValidationBody
+	Status  string                 `json:"status"`
+	Count   int                    `json:"count"`
+	Pending int                    `json:"pending"`
+	Items   []NumberValidationBody `json:"items"`
 }
 
 type NumberValidationPayload struct {
-	PhoneNumbers []string `json:"phone_numbers"`
-	Async        bool     `json:"async"`
-	Type         string   `json:"type"`
+	PhoneNumbers []string       `json:"phone_numbers"`
+	Async        bool           `json:"async"`
+	Type         ValidationType `json:"type"`
 }
 
 type NumberValidationAsyncResponse struct {
 	RequestUUID string `json:"request_uuid"`
 }
 
-func (s *ValidationService) ValidateSingle(number string, validationType string) (*NumberValidationBody, *utils.HttpErrorResponse) {
-	url := fmt.Sprintf("/v1/validation?phone_number=%s&type=%s", number, validationType)
-	return utils.Get[NumberValidationBody](*s.httpConfig, url, NumberValidationBody{})
+func (s *ValidationService) ValidateSingle(number string, validationType ValidationType) (*NumberValidationBody, *utils.HttpErrorResponse) {
+	if err := validationType.validate(); err != nil {
+		return nil, err
+	}
+
+	number, err := normalizePhoneNumber(number)
+
+	if err != nil {
+		return nil, phoneFieldsError(map[string]string{"phone_number": err.Error()})
+	}
+
+	query := url.Values{
+		"phone_number": []string{number},
+		"type":         []string{string(validationType)},
+	}
+
+	return utils.Get[NumberValidationBody](*s.httpConfig, "/v1/validation?"+query.Encode(), NumberValidationBody{})
 }
 
-func (s *ValidationService) ValidateBatch(numbers []string, validationType string) (*NumberValidationResponse, *utils.HttpErrorResponse) {
+func (s *ValidationService) ValidateBatch(numbers []string, validationType ValidationType) (*NumberValidationResponse, *utils.HttpErrorResponse) {
+	if err := validationType.validate(); err != nil {
+		return nil, err
+	}
+
+	phoneNumbers, err := normalizePhoneNumbers(numbers)
+
+	if err != nil {
+		return nil, err
+	}
+
 	return utils.Post[NumberValidationResponse](*s.httpConfig, "/v1/validation", &NumberValidationPayload{
-		PhoneNumbers: numbers,
+		PhoneNumbers: phoneNumbers,
 		Type:         validationType,
 		Async:        false,
 	}, NumberValidationResponse{})
 }
 
-func (s *ValidationService) ValidateBatchAsync(numbers []string, validationType string) (*NumberValidationAsyncResponse, *utils.HttpErrorResponse) {
+func (s *ValidationService) ValidateBatchAsync(numbers []string, validationType ValidationType) (*NumberValidationAsyncResponse, *utils.HttpErrorResponse) {
+	if err := validationType.validate(); err != nil {
+		return nil, err
+	}
+
+	phoneNumbers, err := normalizePhoneNumbers(numbers)
+
+	if err != nil {
+		return nil, err
+	}
+
 	return utils.Post[NumberValidationAsyncResponse](*s.httpConfig, "/v1/validation", &NumberValidationPayload{
-		PhoneNumbers: numbers,
+		PhoneNumbers: phoneNumbers,
 		Type:         validationType,
 		Async:        true,
 	}, NumberValidationAsyncResponse{})
 }
 
 func (s *ValidationService) GetValidationResult(uuid string) (*NumberValidationResponse, *utils.HttpErrorResponse) {
-	url := fmt.Sprintf("/v1/validation/%s", uuid)
-	return utils.Get[NumberValidationResponse](*s.httpConfig, url, NumberValidationResponse{})
+	path := fmt.Sprintf("/v1/validation/%s", url.PathEscape(uuid))
+	return utils.Get[NumberValidationResponse](*s.httpConfig, path, NumberValidationResponse{})
 }
@@ -0,0 +1,241 @@
+package wavix
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const DefaultValidationChunkSize = 100
+
+type ValidateManyOptions struct {
+	ChunkSize       int
+	Concurrency     int
+	PollInterval    time.Duration
+	MaxPollInterval time.Duration
+	OnProgress      func(progress ValidateManyProgress)
+}
+
+type ValidateManyProgress struct {
+	Total           int
+	Completed       int
+	Chunks          int
+	ChunksSubmitted int
+	ChunksCompleted int
+}
+
+type validateManyTracker struct {
+	mu         sync.Mutex
+	progress   ValidateManyProgress
+	chunks     map[int]int
+	onProgress func(progress ValidateManyProgress)
+}
+
+type ValidateManyResult struct {
+	Body NumberValidationBody
+	Err  error
+}
+
+type ValidateManyServiceInterface interface {
+	ValidateMany(ctx context.Context, numbers []string, validationType ValidationType, options ...ValidateManyOptions) <-chan ValidateManyResult
+}
+
+func ValidateMany(ctx context.Context, validation NumberValidationServiceInterface, numbers []string, validationType ValidationType, options ...ValidateManyOptions) <-chan ValidateManyResult {
+	if many, ok := validation.(ValidateManyServiceInterface); ok {
+		return many.ValidateMany(ctx, numbers, validationType, options...)
+	}
+
+	opts := ValidateManyOptions{}
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if opts.ChunkSize <= 0 {
+		opts.ChunkSize = DefaultValidationChunkSize
+	}
+
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 4
+	}
+
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = time.Second
+	}
+
+	if opts.MaxPollInterval < opts.PollInterval {
+		opts.MaxPollInterval = max(30*time.Second, opts.PollInterval)
+	}
+
+	results := make(chan ValidateManyResult, 1)
+
+	if err := validationType.validate(); err != nil {
+		results <- ValidateManyResult{Err: err}
+		close(results)
+		return results
+	}
+
+	go func() {
+		defer close(results)
+
+		chunks := chunkNumbers(numbers, opts.ChunkSize)
+		tracker := &validateManyTracker{
+			progress:   ValidateManyProgress{Total: len(numbers), Chunks: len(chunks)},
+			chunks:     map[int]int{},
+			onProgress: opts.OnProgress,
+		}
+
+		jobCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var once sync.Once
+		var failure error
+		fail := func(err error) {
+			once.Do(func() {
+				failure = err
+				cancel()
+			})
+		}
+
+		semaphore := make(chan struct{}, opts.Concurrency)
+		var wg sync.WaitGroup
+
+	submit:
+		for index, chunk := range chunks {
+			select {
+			case semaphore <- struct{}{}:
+			case <-jobCtx.Done():
+				break submit
+			}
+
+			wg.Add(1)
+			go func(index int, chunk []string) {
+				defer wg.Done()
+				defer func() { <-semaphore }()
+
+				items, err := validateChunk(jobCtx, validation, index, chunk, validationType, opts, tracker)
+
+				if err != nil {
+					fail(err)
+					return
+				}
+
+				for _, item := range items {
+					select {
+					case results <- ValidateManyResult{Body: item}:
+					case <-jobCtx.Done():
+						return
+					}
+				}
+
+				tracker.completeChunk(index, len(chunk))
+			}(index, chunk)
+		}
+
+		wg.Wait()
+
+		if ctx.Err() != nil {
+			fail(ctx.Err())
+		}
+
+		if failure != nil {
+			select {
+			case results <- ValidateManyResult{Err: failure}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return results
+}
+
+func validateChunk(ctx context.Context, validation NumberValidationServiceInterface, index int, chunk []string, validationType ValidationType, opts ValidateManyOptions, tracker *validateManyTracker) ([]NumberValidationBody, error) {
+	job, httpError := validation.ValidateBatchAsync(chunk, validationType)
+
+	if httpError != nil {
+		return nil, httpError
+	}
+
+	tracker.submitChunk()
+
+	interval := opts.PollInterval
+
+	for {
+		timer := time.NewTimer(interval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		result, httpError := validation.GetValidationResult(job.RequestUUID)
+
+		if httpError != nil {
+			return nil, httpError
+		}
+
+		tracker.updateChunk(index, result.Count-result.Pending)
+
+		if result.Pending == 0 && len(result.Items) >= result.Count {
+			return result.Items, nil
+		}
+
+		interval *= 2
+		if interval > opts.MaxPollInterval {
+			interval = opts.MaxPollInterval
+		}
+	}
+}
+
+func (t *validateManyTracker) submitChunk() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.progress.ChunksSubmitted++
+	t.notify()
+}
+
+func (t *validateManyTracker) updateChunk(index int, completed int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if completed < 0 || completed == t.chunks[index] {
+		return
+	}
+
+	t.progress.Completed += completed - t.chunks[index]
+	t.chunks[index] = completed
+	t.notify()
+}
+
+func (t *validateManyTracker) completeChunk(index int, size int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.progress.Completed += size - t.chunks[index]
+	t.chunks[index] = size
+	t.progress.ChunksCompleted++
+	t.notify()
+}
+
+func (t *validateManyTracker) notify() {
+	if t.onProgress != nil {
+		t.onProgress(t.progress)
+	}
+}
+
+func chunkNumbers(numbers []string, size int) [][]string {
+	chunks := [][]string{}
+
+	for start := 0; start < len(numbers); start += size {
+		end := start + size
+		if end > len(numbers) {
+			end = len(numbers)
+		}
+
+		chunks = append(chunks, numbers[start:end])
+	}
+
+	return chunks
+}
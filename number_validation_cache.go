@@ -0,0 +1,461 @@
+package wavix
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+type ValidationCacheTTL struct {
+	Reachable   time.Duration
+	Roaming     time.Duration
+	Ported      time.Duration
+	CarrierName time.Duration
+	Default     time.Duration
+}
+
+func (t ValidationCacheTTL) ForField(field ValidationCacheField) time.Duration {
+	ttl := map[ValidationCacheField]time.Duration{
+		ReachableValidationCacheField:   t.Reachable,
+		RoamingValidationCacheField:     t.Roaming,
+		PortedValidationCacheField:      t.Ported,
+		CarrierNameValidationCacheField: t.CarrierName,
+	}[field]
+
+	if ttl <= 0 || ttl > t.Default {
+		return t.Default
+	}
+
+	return ttl
+}
+
+var DefaultValidationCacheTTL = ValidationCacheTTL{
+	Reachable:   15 * time.Minute,
+	Roaming:     15 * time.Minute,
+	Ported:      24 * time.Hour,
+	CarrierName: 7 * 24 * time.Hour,
+	Default:     30 * 24 * time.Hour,
+}
+
+type ValidationCacheField string
+
+const (
+	ReachableValidationCacheField   ValidationCacheField = "reachable"
+	RoamingValidationCacheField     ValidationCacheField = "roaming"
+	PortedValidationCacheField      ValidationCacheField = "ported"
+	CarrierNameValidationCacheField ValidationCacheField = "carrier_name"
+)
+
+var AnalysisValidationCacheFields = []ValidationCacheField{
+	ReachableValidationCacheField,
+	RoamingValidationCacheField,
+	PortedValidationCacheField,
+	CarrierNameValidationCacheField,
+}
+
+type ValidationCacheEntry struct {
+	Body           NumberValidationBody               `json:"body"`
+	Type           ValidationType                     `json:"type"`
+	FetchedAt      time.Time                          `json:"fetched_at"`
+	ExpiresAt      time.Time                          `json:"expires_at"`
+	FieldExpiresAt map[ValidationCacheField]time.Time `json:"field_expires_at,omitempty"`
+}
+
+func (e ValidationCacheEntry) FreshFor(now time.Time, fields ...ValidationCacheField) bool {
+	if !now.Before(e.ExpiresAt) {
+		return false
+	}
+
+	for _, field := range fields {
+		expiresAt, ok := e.FieldExpiresAt[field]
+
+		if !ok || !now.Before(expiresAt) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type ValidationCache interface {
+	Get(key string) (*ValidationCacheEntry, bool)
+	Set(key string, entry ValidationCacheEntry)
+	Delete(key string)
+}
+
+type ValidationCacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+func (s ValidationCacheStats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+
+	if total == 0 {
+		return 0
+	}
+
+	return float64(s.Hits) / float64(total)
+}
+
+type LRUValidationCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+type lruValidationCacheItem struct {
+	key   string
+	entry ValidationCacheEntry
+}
+
+func NewLRUValidationCache(capacity int) *LRUValidationCache {
+	if capacity <= 0 {
+		capacity = 10000
+	}
+
+	return &LRUValidationCache{capacity: capacity, items: map[string]*list.Element{}, order: list.New()}
+}
+
+func (c *LRUValidationCache) Get(key string) (*ValidationCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.items[key]
+
+	if !ok {
+		return nil, false
+	}
+
+	item := element.Value.(*lruValidationCacheItem)
+
+	if !time.Now().Before(item.entry.ExpiresAt) {
+		c.order.Remove(element)
+		delete(c.items, key)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	entry := item.entry
+	return &entry, true
+}
+
+func (c *LRUValidationCache) Set(key string, entry ValidationCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.items[key]; ok {
+		element.Value.(*lruValidationCacheItem).entry = entry
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&lruValidationCacheItem{key: key, entry: entry})
+
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruValidationCacheItem).key)
+	}
+}
+
+func (c *LRUValidationCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.items[key]; ok {
+		c.order.Remove(element)
+		delete(c.items, key)
+	}
+}
+
+func (c *LRUValidationCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+type ValidationCacheOptions struct {
+	Cache ValidationCache
+	TTL   *ValidationCacheTTL
+}
+
+type CachedValidationService struct {
+	service NumberValidationServiceInterface
+	cache   ValidationCache
+	ttl     ValidationCacheTTL
+	hits    atomic.Uint64
+	misses  atomic.Uint64
+	mu      sync.Mutex
+	jobs    map[string]ValidationType
+}
+
+func NewCachedValidationService(service NumberValidationServiceInterface, options ValidationCacheOptions) *CachedValidationService {
+	if options.Cache == nil {
+		options.Cache = NewLRUValidationCache(0)
+	}
+
+	ttl := DefaultValidationCacheTTL
+	if options.TTL != nil {
+		ttl = *options.TTL
+	}
+
+	if ttl.Default <= 0 {
+		ttl.Default = DefaultValidationCacheTTL.Default
+	}
+
+	return &CachedValidationService{service: service, cache: options.Cache, ttl: ttl, jobs: map[string]ValidationType{}}
+}
+
+func (s *CachedValidationService) Stats() ValidationCacheStats {
+	return ValidationCacheStats{Hits: s.hits.Load(), Misses: s.misses.Load()}
+}
+
+func (s *CachedValidationService) Invalidate(number string) {
+	for _, validationType := range []ValidationType{FormatValidationType, AnalysisValidationType} {
+		s.cache.Delete(validationCacheKey(number, validationType))
+	}
+}
+
+func (s *CachedValidationService) ValidateSingle(number string, validationType ValidationType) (*NumberValidationBody, *utils.HttpErrorResponse) {
+	return s.ValidateSingleFields(number, validationType, validationCacheFieldsFor(validationType)...)
+}
+
+func (s *CachedValidationService) ValidateSingleFields(number string, validationType ValidationType, fields ...ValidationCacheField) (*NumberValidationBody, *utils.HttpErrorResponse) {
+	if body, ok := s.lookupFields(number, validationType, fields); ok {
+		return body, nil
+	}
+
+	body, err := s.service.ValidateSingle(number, validationType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.store(number, validationType, *body)
+
+	return body, nil
+}
+
+func (s *CachedValidationService) ValidateBatch(numbers []string, validationType ValidationType) (*NumberValidationResponse, *utils.HttpErrorResponse) {
+	found := make([]*NumberValidationBody, len(numbers))
+	misses := []string{}
+	missIndexes := []int{}
+
+	for index, number := range numbers {
+		if body, ok := s.lookup(number, validationType); ok {
+			found[index] = body
+		} else {
+			misses = append(misses, number)
+			missIndexes = append(missIndexes, index)
+		}
+	}
+
+	response := &NumberValidationResponse{Status: "completed"}
+	unmatched := []NumberValidationBody{}
+
+	if len(misses) > 0 {
+		fetched, err := s.service.ValidateBatch(misses, validationType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		byKey := map[string][]NumberValidationBody{}
+
+		for _, item := range fetched.Items {
+			s.store(item.PhoneNumber, validationType, item)
+
+			key := validationCacheKey(item.PhoneNumber, validationType)
+			byKey[key] = append(byKey[key], item)
+		}
+
+		for _, index := range missIndexes {
+			key := validationCacheKey(numbers[index], validationType)
+
+			if candidates := byKey[key]; len(candidates) > 0 {
+				found[index] = &candidates[0]
+				byKey[key] = candidates[1:]
+			}
+		}
+
+		for _, item := range fetched.Items {
+			key := validationCacheKey(item.PhoneNumber, validationType)
+
+			if len(byKey[key]) > 0 {
+				unmatched = append(unmatched, byKey[key]...)
+				delete(byKey, key)
+			}
+		}
+
+		response.Status = fetched.Status
+		response.Pending = fetched.Pending
+	}
+
+	items := []NumberValidationBody{}
+
+	for _, body := range found {
+		if body != nil {
+			items = append(items, *body)
+		}
+	}
+
+	response.Items = append(items, unmatched...)
+	response.Count = len(response.Items) + response.Pending
+
+	return response, nil
+}
+
+func (s *CachedValidationService) ValidateBatchAsync(numbers []string, validationType ValidationType) (*NumberValidationAsyncResponse, *utils.HttpErrorResponse) {
+	response, err := s.service.ValidateBatchAsync(numbers, validationType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.jobs[response.RequestUUID] = validationType
+	s.mu.Unlock()
+
+	return response, nil
+}
+
+func (s *CachedValidationService) GetValidationResult(uuid string) (*NumberValidationResponse, *utils.HttpErrorResponse) {
+	response, err := s.service.GetValidationResult(uuid)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Pending == 0 {
+		s.mu.Lock()
+		validationType, ok := s.jobs[uuid]
+		delete(s.jobs, uuid)
+		s.mu.Unlock()
+
+		if ok {
+			for _, item := range response.Items {
+				s.store(item.PhoneNumber, validationType, item)
+			}
+		}
+	}
+
+	return response, nil
+}
+
+func (s *CachedValidationService) ValidateMany(ctx context.Context, numbers []string, validationType ValidationType, options ...ValidateManyOptions) <-chan ValidateManyResult {
+	hits := []NumberValidationBody{}
+	misses := []string{}
+
+	if validationType.IsValid() {
+		for _, number := range numbers {
+			if body, ok := s.lookup(number, validationType); ok {
+				hits = append(hits, *body)
+			} else {
+				misses = append(misses, number)
+			}
+		}
+	} else {
+		misses = numbers
+	}
+
+	results := make(chan ValidateManyResult)
+
+	go func() {
+		defer close(results)
+
+		for _, body := range hits {
+			select {
+			case results <- ValidateManyResult{Body: body}:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if len(misses) == 0 {
+			return
+		}
+
+		for result := range ValidateMany(ctx, s.service, misses, validationType, options...) {
+			if result.Err == nil {
+				s.store(result.Body.PhoneNumber, validationType, result.Body)
+			}
+
+			select {
+			case results <- result:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return results
+}
+
+func (s *CachedValidationService) lookup(number string, validationType ValidationType) (*NumberValidationBody, bool) {
+	return s.lookupFields(number, validationType, validationCacheFieldsFor(validationType))
+}
+
+func (s *CachedValidationService) lookupFields(number string, validationType ValidationType, fields []ValidationCacheField) (*NumberValidationBody, bool) {
+	now := time.Now()
+
+	if entry, ok := s.cache.Get(validationCacheKey(number, validationType)); ok && entry.FreshFor(now, fields...) {
+		s.hits.Add(1)
+		return &entry.Body, true
+	}
+
+	if validationType == FormatValidationType {
+		if entry, ok := s.cache.Get(validationCacheKey(number, AnalysisValidationType)); ok && entry.FreshFor(now, fields...) {
+			s.hits.Add(1)
+			return &entry.Body, true
+		}
+	}
+
+	s.misses.Add(1)
+	return nil, false
+}
+
+func (s *CachedValidationService) store(number string, validationType ValidationType, body NumberValidationBody) {
+	if number == "" || body.ErrorCode != NoneNumberValidationErrorCode {
+		return
+	}
+
+	now := time.Now()
+	entry := ValidationCacheEntry{
+		Body:      body,
+		Type:      validationType,
+		FetchedAt: now,
+		ExpiresAt: now.Add(s.ttl.Default),
+	}
+
+	if fields := validationCacheFieldsFor(validationType); len(fields) > 0 {
+		entry.FieldExpiresAt = map[ValidationCacheField]time.Time{}
+
+		for _, field := range fields {
+			entry.FieldExpiresAt[field] = now.Add(s.ttl.ForField(field))
+		}
+	}
+
+	s.cache.Set(validationCacheKey(number, validationType), entry)
+}
+
+func validationCacheFieldsFor(validationType ValidationType) []ValidationCacheField {
+	if validationType == AnalysisValidationType {
+		return AnalysisValidationCacheFields
+	}
+
+	return nil
+}
+
+func validationCacheKey(number string, validationType ValidationType) string {
+	if normalized, err := normalizePhoneNumber(number); err == nil {
+		number = normalized
+	}
+
+	return number + "|" + string(validationType)
+}
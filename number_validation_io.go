@@ -0,0 +1,394 @@
+package wavix
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+type ValidationColumn string
+
+const (
+	PhoneNumberValidationColumn      ValidationColumn = "phone_number"
+	ValidValidationColumn            ValidationColumn = "valid"
+	CountryCodeValidationColumn      ValidationColumn = "country_code"
+	E164FormatValidationColumn       ValidationColumn = "e164_format"
+	NationalFormatValidationColumn   ValidationColumn = "national_format"
+	PortedValidationColumn           ValidationColumn = "ported"
+	MccValidationColumn              ValidationColumn = "mcc"
+	MncValidationColumn              ValidationColumn = "mnc"
+	NumberTypeValidationColumn       ValidationColumn = "number_type"
+	CarrierNameValidationColumn      ValidationColumn = "carrier_name"
+	RiskyDestinationValidationColumn ValidationColumn = "risky_destination"
+	UnallocatedRangeValidationColumn ValidationColumn = "unallocated_range"
+	ReachableValidationColumn        ValidationColumn = "reachable"
+	RoamingValidationColumn          ValidationColumn = "roaming"
+	TimezoneValidationColumn         ValidationColumn = "timezone"
+	ChargeValidationColumn           ValidationColumn = "charge"
+	ErrorCodeValidationColumn        ValidationColumn = "error_code"
+)
+
+var DefaultValidationColumns = []ValidationColumn{
+	PhoneNumberValidationColumn,
+	ValidValidationColumn,
+	CountryCodeValidationColumn,
+	E164FormatValidationColumn,
+	NationalFormatValidationColumn,
+	PortedValidationColumn,
+	MccValidationColumn,
+	MncValidationColumn,
+	NumberTypeValidationColumn,
+	CarrierNameValidationColumn,
+	RiskyDestinationValidationColumn,
+	UnallocatedRangeValidationColumn,
+	ReachableValidationColumn,
+	RoamingValidationColumn,
+	TimezoneValidationColumn,
+	ChargeValidationColumn,
+	ErrorCodeValidationColumn,
+}
+
+var validationColumnValues = map[ValidationColumn]func(body NumberValidationBody) interface{}{
+	PhoneNumberValidationColumn:      func(body NumberValidationBody) interface{} { return body.PhoneNumber },
+	ValidValidationColumn:            func(body NumberValidationBody) interface{} { return body.Valid },
+	CountryCodeValidationColumn:      func(body NumberValidationBody) interface{} { return body.CountryCode },
+	E164FormatValidationColumn:       func(body NumberValidationBody) interface{} { return body.E164Format },
+	NationalFormatValidationColumn:   func(body NumberValidationBody) interface{} { return body.NationalFormat },
+	PortedValidationColumn:           func(body NumberValidationBody) interface{} { return body.Ported },
+	MccValidationColumn:              func(body NumberValidationBody) interface{} { return body.Mcc },
+	MncValidationColumn:              func(body NumberValidationBody) interface{} { return body.Mnc },
+	NumberTypeValidationColumn:       func(body NumberValidationBody) interface{} { return string(body.NumberType) },
+	CarrierNameValidationColumn:      func(body NumberValidationBody) interface{} { return body.CarrierName },
+	RiskyDestinationValidationColumn: func(body NumberValidationBody) interface{} { return body.RiskyDestination },
+	UnallocatedRangeValidationColumn: func(body NumberValidationBody) interface{} { return body.UnallocatedRange },
+	ReachableValidationColumn:        func(body NumberValidationBody) interface{} { return body.Reachable },
+	RoamingValidationColumn:          func(body NumberValidationBody) interface{} { return body.Roaming },
+	TimezoneValidationColumn:         func(body NumberValidationBody) interface{} { return body.Timezone },
+	ChargeValidationColumn:           func(body NumberValidationBody) interface{} { return body.Charge.String() },
+	ErrorCodeValidationColumn:        func(body NumberValidationBody) interface{} { return string(body.ErrorCode) },
+}
+
+func ParseValidationColumns(value string) ([]ValidationColumn, error) {
+	if strings.TrimSpace(value) == "" {
+		return DefaultValidationColumns, nil
+	}
+
+	columns := []ValidationColumn{}
+
+	for _, name := range strings.Split(value, ",") {
+		column := ValidationColumn(strings.TrimSpace(name))
+
+		if _, ok := validationColumnValues[column]; !ok {
+			return nil, fmt.Errorf("unknown validation column %q", column)
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns, nil
+}
+
+type ValidationResultWriter interface {
+	Write(body NumberValidationBody) error
+	Flush() error
+}
+
+type ValidationCSVWriter struct {
+	writer        *csv.Writer
+	columns       []ValidationColumn
+	headerWritten bool
+}
+
+func NewValidationCSVWriter(w io.Writer, columns []ValidationColumn) (*ValidationCSVWriter, error) {
+	if err := checkValidationColumns(columns); err != nil {
+		return nil, err
+	}
+
+	if len(columns) == 0 {
+		columns = DefaultValidationColumns
+	}
+
+	return &ValidationCSVWriter{writer: csv.NewWriter(w), columns: columns}, nil
+}
+
+func (w *ValidationCSVWriter) Write(body NumberValidationBody) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	record := make([]string, len(w.columns))
+	for index, column := range w.columns {
+		record[index] = fmt.Sprint(validationColumnValues[column](body))
+	}
+
+	return w.writer.Write(record)
+}
+
+func (w *ValidationCSVWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func (w *ValidationCSVWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+
+	header := make([]string, len(w.columns))
+	for index, column := range w.columns {
+		header[index] = string(column)
+	}
+
+	if err := w.writer.Write(header); err != nil {
+		return err
+	}
+
+	w.headerWritten = true
+	return nil
+}
+
+type ValidationJSONLWriter struct {
+	writer  *bufio.Writer
+	encoder *json.Encoder
+	columns []ValidationColumn
+}
+
+func NewValidationJSONLWriter(w io.Writer, columns []ValidationColumn) (*ValidationJSONLWriter, error) {
+	if err := checkValidationColumns(columns); err != nil {
+		return nil, err
+	}
+
+	if len(columns) == 0 {
+		columns = DefaultValidationColumns
+	}
+
+	buffered := bufio.NewWriter(w)
+
+	return &ValidationJSONLWriter{writer: buffered, encoder: json.NewEncoder(buffered), columns: columns}, nil
+}
+
+func (w *ValidationJSONLWriter) Write(body NumberValidationBody) error {
+	record := make(map[string]interface{}, len(w.columns))
+
+	for _, column := range w.columns {
+		record[string(column)] = validationColumnValues[column](body)
+	}
+
+	return w.encoder.Encode(record)
+}
+
+func (w *ValidationJSONLWriter) Flush() error {
+	return w.writer.Flush()
+}
+
+func ReadNumbersCSV(r io.Reader, column string) ([]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+
+	if errors.Is(err, io.EOF) {
+		return []string{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	index, hasHeader := csvColumnIndex(header, column)
+
+	if index < 0 {
+		return nil, fmt.Errorf("column %q not found in CSV header", column)
+	}
+
+	numbers := []string{}
+
+	if !hasHeader && index < len(header) && strings.ContainsAny(header[index], "0123456789") {
+		numbers = append(numbers, strings.TrimSpace(header[index]))
+	}
+
+	for {
+		record, err := reader.Read()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if index < len(record) && strings.TrimSpace(record[index]) != "" {
+			numbers = append(numbers, strings.TrimSpace(record[index]))
+		}
+	}
+
+	return numbers, nil
+}
+
+func ReadNumbersJSONL(r io.Reader, field string) ([]string, error) {
+	if field == "" {
+		field = string(PhoneNumberValidationColumn)
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	numbers := []string{}
+	line := 0
+
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+
+		if text == "" {
+			continue
+		}
+
+		var value interface{}
+		if err := json.Unmarshal([]byte(text), &value); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		switch typed := value.(type) {
+		case string:
+			numbers = append(numbers, typed)
+		case float64:
+			numbers = append(numbers, strconv.FormatFloat(typed, 'f', -1, 64))
+		case map[string]interface{}:
+			if number, ok := typed[field]; ok && number != nil {
+				numbers = append(numbers, fmt.Sprint(number))
+			}
+		default:
+			return nil, fmt.Errorf("line %d: unsupported JSON value", line)
+		}
+	}
+
+	return numbers, scanner.Err()
+}
+
+type ValidationSummary struct {
+	Total       int         `json:"total"`
+	Valid       int         `json:"valid"`
+	Invalid     int         `json:"invalid"`
+	Risky       int         `json:"risky"`
+	Errors      int         `json:"errors"`
+	TotalCharge utils.Money `json:"total_charge"`
+}
+
+func (s *ValidationSummary) Add(body NumberValidationBody) {
+	s.Total++
+
+	if body.Valid {
+		s.Valid++
+	} else {
+		s.Invalid++
+	}
+
+	if body.RiskyDestination {
+		s.Risky++
+	}
+
+	if body.ErrorCode != NoneNumberValidationErrorCode {
+		s.Errors++
+	}
+
+	s.TotalCharge = s.TotalCharge.Add(body.Charge)
+}
+
+type ValidationJobOptions struct {
+	ValidateManyOptions
+	Type ValidationType
+}
+
+func RunValidationJob(ctx context.Context, service NumberValidationServiceInterface, numbers []string, writer ValidationResultWriter, options ValidationJobOptions) (*ValidationSummary, error) {
+	if options.Type == "" {
+		options.Type = FormatValidationType
+	}
+
+	summary := &ValidationSummary{}
+	var writeErr error
+	var validateErr error
+	valid := make([]string, 0, len(numbers))
+
+	for _, number := range numbers {
+		normalized, err := normalizePhoneNumber(number)
+
+		if err == nil && normalized != "" {
+			valid = append(valid, normalized)
+			continue
+		}
+
+		body := NumberValidationBody{PhoneNumber: number, ErrorCode: InvalidFormatNumberValidationErrorCode}
+		summary.Add(body)
+
+		if writeErr == nil {
+			writeErr = writer.Write(body)
+		}
+	}
+
+	for result := range ValidateMany(ctx, service, valid, options.Type, options.ValidateManyOptions) {
+		if result.Err != nil {
+			validateErr = result.Err
+			continue
+		}
+
+		summary.Add(result.Body)
+
+		if writeErr == nil {
+			writeErr = writer.Write(result.Body)
+		}
+	}
+
+	flushErr := writer.Flush()
+
+	if validateErr != nil {
+		return summary, validateErr
+	}
+
+	if writeErr != nil {
+		return summary, writeErr
+	}
+
+	return summary, flushErr
+}
+
+func checkValidationColumns(columns []ValidationColumn) error {
+	for _, column := range columns {
+		if _, ok := validationColumnValues[column]; !ok {
+			return fmt.Errorf("unknown validation column %q", column)
+		}
+	}
+
+	return nil
+}
+
+func csvColumnIndex(header []string, column string) (int, bool) {
+	if column == "" {
+		column = string(PhoneNumberValidationColumn)
+	}
+
+	for index, name := range header {
+		if strings.EqualFold(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")), column) {
+			return index, true
+		}
+	}
+
+	if index, err := strconv.Atoi(column); err == nil && index >= 0 {
+		return index, false
+	}
+
+	return -1, false
+}
@@ -0,0 +1,73 @@
+package wavix
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+type fakeValidationService struct {
+	submitted [][]string
+}
+
+func (f *fakeValidationService) ValidateSingle(number string, validationType ValidationType) (*NumberValidationBody, *utils.HttpErrorResponse) {
+	return &NumberValidationBody{PhoneNumber: number, Valid: true}, nil
+}
+
+func (f *fakeValidationService) ValidateBatch(numbers []string, validationType ValidationType) (*NumberValidationResponse, *utils.HttpErrorResponse) {
+	return nil, unsupportedOperationError("ValidateBatch")
+}
+
+func (f *fakeValidationService) ValidateBatchAsync(numbers []string, validationType ValidationType) (*NumberValidationAsyncResponse, *utils.HttpErrorResponse) {
+	if _, err := normalizePhoneNumbers(numbers); err != nil {
+		return nil, err
+	}
+
+	f.submitted = append(f.submitted, numbers)
+	return &NumberValidationAsyncResponse{RequestUUID: strings.Join(numbers, ",")}, nil
+}
+
+func (f *fakeValidationService) GetValidationResult(uuid string) (*NumberValidationResponse, *utils.HttpErrorResponse) {
+	items := []NumberValidationBody{}
+
+	for _, number := range strings.Split(uuid, ",") {
+		items = append(items, NumberValidationBody{PhoneNumber: number, Valid: true})
+	}
+
+	return &NumberValidationResponse{Count: len(items), Items: items}, nil
+}
+
+func TestRunValidationJobReportsInvalidRowsWithoutFailing(t *testing.T) {
+	service := &fakeValidationService{}
+	var out bytes.Buffer
+
+	writer, err := NewValidationCSVWriter(&out, []ValidationColumn{PhoneNumberValidationColumn, ValidValidationColumn, ErrorCodeValidationColumn})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	numbers := []string{"+1 212 555 0100", "not a number", "+44 20 7946 0958", ""}
+	summary, err := RunValidationJob(context.Background(), service, numbers, writer, ValidationJobOptions{
+		ValidateManyOptions: ValidateManyOptions{ChunkSize: 10, PollInterval: time.Millisecond},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if summary.Total != 4 || summary.Valid != 2 || summary.Errors != 2 {
+		t.Fatalf("summary %+v", summary)
+	}
+
+	if len(service.submitted) != 1 || len(service.submitted[0]) != 2 {
+		t.Fatalf("submitted %v", service.submitted)
+	}
+
+	if !strings.Contains(out.String(), "not a number,false,invalid_format") {
+		t.Fatalf("output %q", out.String())
+	}
+}
@@ -0,0 +1,380 @@
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+type Type int
+
+const (
+	String Type = iota
+	Int64
+	Double
+	Timestamp
+)
+
+type Codec int
+
+const (
+	Uncompressed Codec = 0
+	Gzip         Codec = 2
+)
+
+type Column struct {
+	Name string
+	Type Type
+}
+
+type Options struct {
+	Codec        Codec
+	RowGroupSize int
+	CreatedBy    string
+}
+
+var magic = []byte("PAR1")
+
+var ErrClosed = errors.New("parquet writer is closed")
+
+type Writer struct {
+	w         *countingWriter
+	columns   []Column
+	options   Options
+	buffers   []bytes.Buffer
+	rows      int
+	rowGroups []rowGroup
+	totalRows int64
+	closed    bool
+}
+
+type rowGroup struct {
+	rows    int64
+	size    int64
+	columns []columnChunk
+}
+
+type columnChunk struct {
+	offset           int64
+	values           int64
+	uncompressedSize int64
+	compressedSize   int64
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func NewWriter(w io.Writer, columns []Column, options Options) (*Writer, error) {
+	if len(columns) == 0 {
+		return nil, errors.New("parquet schema has no columns")
+	}
+
+	if options.Codec != Uncompressed && options.Codec != Gzip {
+		return nil, fmt.Errorf("unsupported parquet codec %d", options.Codec)
+	}
+
+	if options.RowGroupSize <= 0 {
+		options.RowGroupSize = 10000
+	}
+
+	if options.CreatedBy == "" {
+		options.CreatedBy = "github.com/wavix/sdk-go"
+	}
+
+	writer := &Writer{w: &countingWriter{w: w}, columns: columns, options: options, buffers: make([]bytes.Buffer, len(columns))}
+
+	if _, err := writer.w.Write(magic); err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+func (w *Writer) Write(row []interface{}) error {
+	if w.closed {
+		return ErrClosed
+	}
+
+	if len(row) != len(w.columns) {
+		return fmt.Errorf("parquet row has %d values, schema has %d columns", len(row), len(w.columns))
+	}
+
+	lengths := make([]int, len(w.buffers))
+
+	for index := range w.buffers {
+		lengths[index] = w.buffers[index].Len()
+	}
+
+	for index, column := range w.columns {
+		if err := encodeValue(&w.buffers[index], column, row[index]); err != nil {
+			for index, length := range lengths {
+				w.buffers[index].Truncate(length)
+			}
+
+			return err
+		}
+	}
+
+	w.rows++
+
+	if w.rows >= w.options.RowGroupSize {
+		return w.Flush()
+	}
+
+	return nil
+}
+
+func (w *Writer) Flush() error {
+	if w.closed {
+		return ErrClosed
+	}
+
+	if w.rows == 0 {
+		return nil
+	}
+
+	group := rowGroup{rows: int64(w.rows)}
+
+	for index := range w.columns {
+		chunk, err := w.writeColumnChunk(w.buffers[index].Bytes())
+
+		if err != nil {
+			return err
+		}
+
+		group.size += chunk.uncompressedSize
+		group.columns = append(group.columns, chunk)
+		w.buffers[index].Reset()
+	}
+
+	w.rowGroups = append(w.rowGroups, group)
+	w.totalRows += int64(w.rows)
+	w.rows = 0
+
+	return nil
+}
+
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	w.closed = true
+	footer := w.fileMetadata()
+
+	if _, err := w.w.Write(footer); err != nil {
+		return err
+	}
+
+	if err := binary.Write(w.w, binary.LittleEndian, uint32(len(footer))); err != nil {
+		return err
+	}
+
+	_, err := w.w.Write(magic)
+	return err
+}
+
+func (w *Writer) writeColumnChunk(values []byte) (columnChunk, error) {
+	page := values
+
+	if w.options.Codec == Gzip {
+		var compressed bytes.Buffer
+		zw := gzip.NewWriter(&compressed)
+
+		if _, err := zw.Write(values); err != nil {
+			return columnChunk{}, err
+		}
+
+		if err := zw.Close(); err != nil {
+			return columnChunk{}, err
+		}
+
+		page = compressed.Bytes()
+	}
+
+	header := &thriftWriter{}
+	header.beginStruct(0)
+	header.i32(1, 0)
+	header.i32(2, int32(len(values)))
+	header.i32(3, int32(len(page)))
+	header.beginStruct(5)
+	header.i32(1, int32(w.rows))
+	header.i32(2, 0)
+	header.i32(3, 3)
+	header.i32(4, 3)
+	header.endStruct()
+	header.endStruct()
+
+	chunk := columnChunk{
+		offset:           w.w.n,
+		values:           int64(w.rows),
+		uncompressedSize: int64(header.buf.Len() + len(values)),
+		compressedSize:   int64(header.buf.Len() + len(page)),
+	}
+
+	if _, err := w.w.Write(header.buf.Bytes()); err != nil {
+		return columnChunk{}, err
+	}
+
+	if _, err := w.w.Write(page); err != nil {
+		return columnChunk{}, err
+	}
+
+	return chunk, nil
+}
+
+func (w *Writer) fileMetadata() []byte {
+	meta := &thriftWriter{}
+	meta.beginStruct(0)
+	meta.i32(1, 1)
+
+	meta.listHeader(2, thriftStruct, len(w.columns)+1)
+	meta.beginStruct(0)
+	meta.string(4, "schema")
+	meta.i32(5, int32(len(w.columns)))
+	meta.endStruct()
+
+	for _, column := range w.columns {
+		physical, converted := columnTypes(column.Type)
+
+		meta.beginStruct(0)
+		meta.i32(1, physical)
+		meta.i32(3, 0)
+		meta.string(4, column.Name)
+		if converted >= 0 {
+			meta.i32(6, converted)
+		}
+		meta.endStruct()
+	}
+
+	meta.i64(3, w.totalRows)
+
+	meta.listHeader(4, thriftStruct, len(w.rowGroups))
+	for _, group := range w.rowGroups {
+		meta.beginStruct(0)
+		meta.listHeader(1, thriftStruct, len(group.columns))
+
+		for index, chunk := range group.columns {
+			physical, _ := columnTypes(w.columns[index].Type)
+
+			meta.beginStruct(0)
+			meta.i64(2, chunk.offset)
+			meta.beginStruct(3)
+			meta.i32(1, physical)
+			meta.i32List(2, []int32{0, 3})
+			meta.stringList(3, []string{w.columns[index].Name})
+			meta.i32(4, int32(w.options.Codec))
+			meta.i64(5, chunk.values)
+			meta.i64(6, chunk.uncompressedSize)
+			meta.i64(7, chunk.compressedSize)
+			meta.i64(9, chunk.offset)
+			meta.endStruct()
+			meta.endStruct()
+		}
+
+		meta.i64(2, group.size)
+		meta.i64(3, group.rows)
+		meta.endStruct()
+	}
+
+	meta.string(6, w.options.CreatedBy)
+	meta.endStruct()
+
+	return meta.buf.Bytes()
+}
+
+func columnTypes(columnType Type) (int32, int32) {
+	switch columnType {
+	case Int64:
+		return 2, -1
+	case Double:
+		return 5, -1
+	case Timestamp:
+		return 2, 9
+	}
+
+	return 6, 0
+}
+
+func encodeValue(buf *bytes.Buffer, column Column, value interface{}) error {
+	var scratch [8]byte
+
+	switch column.Type {
+	case String:
+		text := ""
+
+		switch typed := value.(type) {
+		case string:
+			text = typed
+		case fmt.Stringer:
+			text = typed.String()
+		case nil:
+			return fmt.Errorf("column %q is required, got nil", column.Name)
+		default:
+			text = fmt.Sprint(typed)
+		}
+
+		binary.LittleEndian.PutUint32(scratch[:4], uint32(len(text)))
+		buf.Write(scratch[:4])
+		buf.WriteString(text)
+	case Int64:
+		number, ok := toInt64(value)
+
+		if !ok {
+			return fmt.Errorf("column %q expects an integer, got %T", column.Name, value)
+		}
+
+		binary.LittleEndian.PutUint64(scratch[:], uint64(number))
+		buf.Write(scratch[:])
+	case Double:
+		number, ok := value.(float64)
+
+		if !ok {
+			return fmt.Errorf("column %q expects a float64, got %T", column.Name, value)
+		}
+
+		binary.LittleEndian.PutUint64(scratch[:], math.Float64bits(number))
+		buf.Write(scratch[:])
+	case Timestamp:
+		t, ok := value.(time.Time)
+
+		if !ok {
+			return fmt.Errorf("column %q expects a time.Time, got %T", column.Name, value)
+		}
+
+		binary.LittleEndian.PutUint64(scratch[:], uint64(t.UnixMilli()))
+		buf.Write(scratch[:])
+	}
+
+	return nil
+}
+
+func toInt64(value interface{}) (int64, bool) {
+	switch typed := value.(type) {
+	case int:
+		return int64(typed), true
+	case int32:
+		return int64(typed), true
+	case int64:
+		return typed, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,102 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+type thriftWriter struct {
+	buf    bytes.Buffer
+	last   []int16
+	fields int16
+}
+
+func (w *thriftWriter) varint(value uint64) {
+	var scratch [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(scratch[:], value)
+	w.buf.Write(scratch[:n])
+}
+
+func (w *thriftWriter) zigzag(value int64) {
+	w.varint(uint64((value << 1) ^ (value >> 63)))
+}
+
+func (w *thriftWriter) fieldHeader(id int16, fieldType byte) {
+	delta := id - w.fields
+
+	if delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | fieldType)
+	} else {
+		w.buf.WriteByte(fieldType)
+		w.zigzag(int64(id))
+	}
+
+	w.fields = id
+}
+
+func (w *thriftWriter) i32(id int16, value int32) {
+	w.fieldHeader(id, thriftI32)
+	w.zigzag(int64(value))
+}
+
+func (w *thriftWriter) i64(id int16, value int64) {
+	w.fieldHeader(id, thriftI64)
+	w.zigzag(value)
+}
+
+func (w *thriftWriter) string(id int16, value string) {
+	w.fieldHeader(id, thriftBinary)
+	w.varint(uint64(len(value)))
+	w.buf.WriteString(value)
+}
+
+func (w *thriftWriter) listHeader(id int16, elementType byte, size int) {
+	w.fieldHeader(id, thriftList)
+
+	if size < 15 {
+		w.buf.WriteByte(byte(size)<<4 | elementType)
+	} else {
+		w.buf.WriteByte(0xf0 | elementType)
+		w.varint(uint64(size))
+	}
+}
+
+func (w *thriftWriter) i32List(id int16, values []int32) {
+	w.listHeader(id, thriftI32, len(values))
+
+	for _, value := range values {
+		w.zigzag(int64(value))
+	}
+}
+
+func (w *thriftWriter) stringList(id int16, values []string) {
+	w.listHeader(id, thriftBinary, len(values))
+
+	for _, value := range values {
+		w.varint(uint64(len(value)))
+		w.buf.WriteString(value)
+	}
+}
+
+func (w *thriftWriter) beginStruct(id int16) {
+	if id > 0 {
+		w.fieldHeader(id, thriftStruct)
+	}
+
+	w.last = append(w.last, w.fields)
+	w.fields = 0
+}
+
+func (w *thriftWriter) endStruct() {
+	w.buf.WriteByte(0)
+	w.fields = w.last[len(w.last)-1]
+	w.last = w.last[:len(w.last)-1]
+}
@@ -0,0 +1,90 @@
+package wavix
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wavix/sdk-go/phonenumber"
+	"github.com/wavix/sdk-go/utils"
+)
+
+func normalizePhoneNumber(number string) (string, error) {
+	if strings.TrimSpace(number) == "" {
+		return "", nil
+	}
+
+	parsed, err := phonenumber.Parse(number, "")
+
+	if err != nil {
+		return "", fmt.Errorf("invalid phone number %q: %w", number, err)
+	}
+
+	return parsed.Digits(), nil
+}
+
+func normalizeSenderId(sender string) (string, error) {
+	if utils.IsSenderId(sender) && strings.ContainsFunc(sender, isSenderLetter) {
+		return sender, nil
+	}
+
+	return normalizePhoneNumber(sender)
+}
+
+func normalizePhoneFields(fields map[string]*string) *utils.HttpErrorResponse {
+	errors := map[string]string{}
+
+	for name, value := range fields {
+		normalized, err := normalizePhoneNumber(*value)
+
+		if err != nil {
+			errors[name] = err.Error()
+			continue
+		}
+
+		*value = normalized
+	}
+
+	return phoneFieldsError(errors)
+}
+
+func normalizePhoneNumbers(numbers []string) ([]string, *utils.HttpErrorResponse) {
+	normalized := make([]string, len(numbers))
+	errors := map[string]string{}
+
+	for index, number := range numbers {
+		value, err := normalizePhoneNumber(number)
+
+		if err != nil {
+			errors[fmt.Sprintf("phone_numbers[%d]", index)] = err.Error()
+			continue
+		}
+
+		normalized[index] = value
+	}
+
+	return normalized, phoneFieldsError(errors)
+}
+
+func phoneFieldsError(errors map[string]string) *utils.HttpErrorResponse {
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return &utils.HttpErrorResponse{Success: false, Message: "Validation failed", Errors: &errors}
+}
+
+func phoneDigits(number string) string {
+	var builder strings.Builder
+
+	for _, char := range number {
+		if char >= '0' && char <= '9' {
+			builder.WriteRune(char)
+		}
+	}
+
+	return builder.String()
+}
+
+func isSenderLetter(char rune) bool {
+	return (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z')
+}
@@ -0,0 +1,74 @@
+package wavix
+
+import "testing"
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	tests := []struct {
+		number string
+		want   string
+		err    bool
+	}{
+		{number: "+1 (415) 555-0100", want: "14155550100"},
+		{number: "14155550100", want: "14155550100"},
+		{number: "+44 20 7123 4567", want: "442071234567"},
+		{number: "4930123456", want: "4930123456"},
+		{number: "", want: ""},
+		{number: "020 7123 4567", err: true},
+		{number: "02071234567", err: true},
+		{number: "0049 30 123456", err: true},
+		{number: "5550100000", err: true},
+		{number: "not a number", err: true},
+	}
+
+	for _, test := range tests {
+		got, err := normalizePhoneNumber(test.number)
+
+		if test.err {
+			if err == nil {
+				t.Errorf("normalizePhoneNumber(%q) = %q, want error", test.number, got)
+			}
+			continue
+		}
+
+		if err != nil || got != test.want {
+			t.Errorf("normalizePhoneNumber(%q) = %q, %v, want %q", test.number, got, err, test.want)
+		}
+	}
+}
+
+func TestNormalizeSenderId(t *testing.T) {
+	tests := []struct {
+		sender string
+		want   string
+		err    bool
+	}{
+		{sender: "Wavix", want: "Wavix"},
+		{sender: "ACME 24", want: "ACME 24"},
+		{sender: "+1 415 555 0100", want: "14155550100"},
+		{sender: "0207 123 4567", err: true},
+	}
+
+	for _, test := range tests {
+		got, err := normalizeSenderId(test.sender)
+
+		if test.err {
+			if err == nil {
+				t.Errorf("normalizeSenderId(%q) = %q, want error", test.sender, got)
+			}
+			continue
+		}
+
+		if err != nil || got != test.want {
+			t.Errorf("normalizeSenderId(%q) = %q, %v, want %q", test.sender, got, err, test.want)
+		}
+	}
+}
+
+func TestSendMessageRejectsNationalNumbers(t *testing.T) {
+	service := &SmsService{}
+	_, err := service.SendMessage(SendMessagePayload{From: "Wavix", To: "020 7123 4567", MessageBody: MessageBody{Text: "hello"}})
+
+	if err == nil || err.Errors == nil || (*err.Errors)["to"] == "" {
+		t.Fatalf("SendMessage error = %+v, want field error for to", err)
+	}
+}
@@ -0,0 +1,233 @@
+package phonenumber
+
+var regions = []Region{
+	{Code: "US", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "CA", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "AG", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "AI", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "AS", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "BB", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "BM", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "BS", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "DM", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "DO", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "GD", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "GU", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "JM", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "KN", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "KY", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "LC", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "MP", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "MS", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "PR", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "SX", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "TC", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "TT", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "VC", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "VG", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "VI", CallingCode: 1, TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	{Code: "RU", CallingCode: 7, TrunkPrefix: "8", MinLength: 10, MaxLength: 10},
+	{Code: "KZ", CallingCode: 7, TrunkPrefix: "8", MinLength: 10, MaxLength: 10},
+	{Code: "EG", CallingCode: 20, TrunkPrefix: "0", MinLength: 8, MaxLength: 10},
+	{Code: "ZA", CallingCode: 27, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "GR", CallingCode: 30, TrunkPrefix: "", MinLength: 10, MaxLength: 10},
+	{Code: "NL", CallingCode: 31, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "BE", CallingCode: 32, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "FR", CallingCode: 33, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "ES", CallingCode: 34, TrunkPrefix: "", MinLength: 9, MaxLength: 9},
+	{Code: "HU", CallingCode: 36, TrunkPrefix: "06", MinLength: 8, MaxLength: 9},
+	{Code: "IT", CallingCode: 39, TrunkPrefix: "", MinLength: 6, MaxLength: 11},
+	{Code: "RO", CallingCode: 40, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "CH", CallingCode: 41, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "AT", CallingCode: 43, TrunkPrefix: "0", MinLength: 4, MaxLength: 13},
+	{Code: "GB", CallingCode: 44, TrunkPrefix: "0", MinLength: 7, MaxLength: 10},
+	{Code: "DK", CallingCode: 45, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "SE", CallingCode: 46, TrunkPrefix: "0", MinLength: 7, MaxLength: 9},
+	{Code: "NO", CallingCode: 47, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "PL", CallingCode: 48, TrunkPrefix: "", MinLength: 9, MaxLength: 9},
+	{Code: "DE", CallingCode: 49, TrunkPrefix: "0", MinLength: 5, MaxLength: 13},
+	{Code: "PE", CallingCode: 51, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "MX", CallingCode: 52, TrunkPrefix: "", MinLength: 10, MaxLength: 10},
+	{Code: "CU", CallingCode: 53, TrunkPrefix: "0", MinLength: 6, MaxLength: 8},
+	{Code: "AR", CallingCode: 54, TrunkPrefix: "0", MinLength: 10, MaxLength: 10},
+	{Code: "BR", CallingCode: 55, TrunkPrefix: "0", MinLength: 10, MaxLength: 11},
+	{Code: "CL", CallingCode: 56, TrunkPrefix: "", MinLength: 9, MaxLength: 9},
+	{Code: "CO", CallingCode: 57, TrunkPrefix: "0", MinLength: 8, MaxLength: 10},
+	{Code: "VE", CallingCode: 58, TrunkPrefix: "0", MinLength: 10, MaxLength: 10},
+	{Code: "MY", CallingCode: 60, TrunkPrefix: "0", MinLength: 8, MaxLength: 10},
+	{Code: "AU", CallingCode: 61, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "ID", CallingCode: 62, TrunkPrefix: "0", MinLength: 8, MaxLength: 12},
+	{Code: "PH", CallingCode: 63, TrunkPrefix: "0", MinLength: 8, MaxLength: 10},
+	{Code: "NZ", CallingCode: 64, TrunkPrefix: "0", MinLength: 8, MaxLength: 10},
+	{Code: "SG", CallingCode: 65, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "TH", CallingCode: 66, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "JP", CallingCode: 81, TrunkPrefix: "0", MinLength: 9, MaxLength: 10},
+	{Code: "KR", CallingCode: 82, TrunkPrefix: "0", MinLength: 8, MaxLength: 10},
+	{Code: "VN", CallingCode: 84, TrunkPrefix: "0", MinLength: 9, MaxLength: 10},
+	{Code: "CN", CallingCode: 86, TrunkPrefix: "0", MinLength: 7, MaxLength: 11},
+	{Code: "TR", CallingCode: 90, TrunkPrefix: "0", MinLength: 10, MaxLength: 10},
+	{Code: "IN", CallingCode: 91, TrunkPrefix: "0", MinLength: 10, MaxLength: 10},
+	{Code: "PK", CallingCode: 92, TrunkPrefix: "0", MinLength: 9, MaxLength: 10},
+	{Code: "AF", CallingCode: 93, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "LK", CallingCode: 94, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "MM", CallingCode: 95, TrunkPrefix: "0", MinLength: 7, MaxLength: 10},
+	{Code: "IR", CallingCode: 98, TrunkPrefix: "0", MinLength: 10, MaxLength: 10},
+	{Code: "SS", CallingCode: 211, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "MA", CallingCode: 212, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "DZ", CallingCode: 213, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "TN", CallingCode: 216, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "LY", CallingCode: 218, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "GM", CallingCode: 220, TrunkPrefix: "", MinLength: 7, MaxLength: 7},
+	{Code: "SN", CallingCode: 221, TrunkPrefix: "", MinLength: 9, MaxLength: 9},
+	{Code: "MR", CallingCode: 222, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "ML", CallingCode: 223, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "GN", CallingCode: 224, TrunkPrefix: "", MinLength: 8, MaxLength: 9},
+	{Code: "CI", CallingCode: 225, TrunkPrefix: "", MinLength: 10, MaxLength: 10},
+	{Code: "BF", CallingCode: 226, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "NE", CallingCode: 227, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "TG", CallingCode: 228, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "BJ", CallingCode: 229, TrunkPrefix: "", MinLength: 8, MaxLength: 10},
+	{Code: "MU", CallingCode: 230, TrunkPrefix: "", MinLength: 7, MaxLength: 8},
+	{Code: "LR", CallingCode: 231, TrunkPrefix: "0", MinLength: 7, MaxLength: 9},
+	{Code: "SL", CallingCode: 232, TrunkPrefix: "0", MinLength: 8, MaxLength: 8},
+	{Code: "GH", CallingCode: 233, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "NG", CallingCode: 234, TrunkPrefix: "0", MinLength: 8, MaxLength: 10},
+	{Code: "TD", CallingCode: 235, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "CF", CallingCode: 236, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "CM", CallingCode: 237, TrunkPrefix: "", MinLength: 9, MaxLength: 9},
+	{Code: "CV", CallingCode: 238, TrunkPrefix: "", MinLength: 7, MaxLength: 7},
+	{Code: "ST", CallingCode: 239, TrunkPrefix: "", MinLength: 7, MaxLength: 7},
+	{Code: "GQ", CallingCode: 240, TrunkPrefix: "", MinLength: 9, MaxLength: 9},
+	{Code: "GA", CallingCode: 241, TrunkPrefix: "", MinLength: 7, MaxLength: 8},
+	{Code: "CG", CallingCode: 242, TrunkPrefix: "", MinLength: 9, MaxLength: 9},
+	{Code: "CD", CallingCode: 243, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "AO", CallingCode: 244, TrunkPrefix: "", MinLength: 9, MaxLength: 9},
+	{Code: "GW", CallingCode: 245, TrunkPrefix: "", MinLength: 7, MaxLength: 9},
+	{Code: "SC", CallingCode: 248, TrunkPrefix: "", MinLength: 7, MaxLength: 7},
+	{Code: "SD", CallingCode: 249, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "RW", CallingCode: 250, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "ET", CallingCode: 251, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "SO", CallingCode: 252, TrunkPrefix: "0", MinLength: 7, MaxLength: 9},
+	{Code: "DJ", CallingCode: 253, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "KE", CallingCode: 254, TrunkPrefix: "0", MinLength: 9, MaxLength: 10},
+	{Code: "TZ", CallingCode: 255, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "UG", CallingCode: 256, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "BI", CallingCode: 257, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "MZ", CallingCode: 258, TrunkPrefix: "", MinLength: 8, MaxLength: 9},
+	{Code: "ZM", CallingCode: 260, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "MG", CallingCode: 261, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "RE", CallingCode: 262, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "ZW", CallingCode: 263, TrunkPrefix: "0", MinLength: 9, MaxLength: 10},
+	{Code: "NA", CallingCode: 264, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "MW", CallingCode: 265, TrunkPrefix: "0", MinLength: 7, MaxLength: 9},
+	{Code: "LS", CallingCode: 266, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "BW", CallingCode: 267, TrunkPrefix: "", MinLength: 7, MaxLength: 8},
+	{Code: "SZ", CallingCode: 268, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "KM", CallingCode: 269, TrunkPrefix: "", MinLength: 7, MaxLength: 7},
+	{Code: "SH", CallingCode: 290, TrunkPrefix: "", MinLength: 4, MaxLength: 5},
+	{Code: "ER", CallingCode: 291, TrunkPrefix: "0", MinLength: 7, MaxLength: 7},
+	{Code: "AW", CallingCode: 297, TrunkPrefix: "", MinLength: 7, MaxLength: 7},
+	{Code: "FO", CallingCode: 298, TrunkPrefix: "", MinLength: 6, MaxLength: 6},
+	{Code: "GL", CallingCode: 299, TrunkPrefix: "", MinLength: 6, MaxLength: 6},
+	{Code: "GI", CallingCode: 350, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "PT", CallingCode: 351, TrunkPrefix: "", MinLength: 9, MaxLength: 9},
+	{Code: "LU", CallingCode: 352, TrunkPrefix: "", MinLength: 4, MaxLength: 11},
+	{Code: "IE", CallingCode: 353, TrunkPrefix: "0", MinLength: 7, MaxLength: 9},
+	{Code: "IS", CallingCode: 354, TrunkPrefix: "", MinLength: 7, MaxLength: 9},
+	{Code: "AL", CallingCode: 355, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "MT", CallingCode: 356, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "CY", CallingCode: 357, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "FI", CallingCode: 358, TrunkPrefix: "0", MinLength: 5, MaxLength: 12},
+	{Code: "BG", CallingCode: 359, TrunkPrefix: "0", MinLength: 7, MaxLength: 9},
+	{Code: "LT", CallingCode: 370, TrunkPrefix: "8", MinLength: 8, MaxLength: 8},
+	{Code: "LV", CallingCode: 371, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "EE", CallingCode: 372, TrunkPrefix: "", MinLength: 7, MaxLength: 10},
+	{Code: "MD", CallingCode: 373, TrunkPrefix: "0", MinLength: 8, MaxLength: 8},
+	{Code: "AM", CallingCode: 374, TrunkPrefix: "0", MinLength: 8, MaxLength: 8},
+	{Code: "BY", CallingCode: 375, TrunkPrefix: "8", MinLength: 9, MaxLength: 10},
+	{Code: "AD", CallingCode: 376, TrunkPrefix: "", MinLength: 6, MaxLength: 9},
+	{Code: "MC", CallingCode: 377, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "SM", CallingCode: 378, TrunkPrefix: "", MinLength: 6, MaxLength: 10},
+	{Code: "UA", CallingCode: 380, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "RS", CallingCode: 381, TrunkPrefix: "0", MinLength: 8, MaxLength: 12},
+	{Code: "ME", CallingCode: 382, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "XK", CallingCode: 383, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "HR", CallingCode: 385, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "SI", CallingCode: 386, TrunkPrefix: "0", MinLength: 8, MaxLength: 8},
+	{Code: "BA", CallingCode: 387, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "MK", CallingCode: 389, TrunkPrefix: "0", MinLength: 8, MaxLength: 8},
+	{Code: "CZ", CallingCode: 420, TrunkPrefix: "", MinLength: 9, MaxLength: 9},
+	{Code: "SK", CallingCode: 421, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "LI", CallingCode: 423, TrunkPrefix: "", MinLength: 7, MaxLength: 9},
+	{Code: "FK", CallingCode: 500, TrunkPrefix: "", MinLength: 5, MaxLength: 5},
+	{Code: "BZ", CallingCode: 501, TrunkPrefix: "", MinLength: 7, MaxLength: 7},
+	{Code: "GT", CallingCode: 502, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "SV", CallingCode: 503, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "HN", CallingCode: 504, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "NI", CallingCode: 505, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "CR", CallingCode: 506, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "PA", CallingCode: 507, TrunkPrefix: "", MinLength: 7, MaxLength: 8},
+	{Code: "PM", CallingCode: 508, TrunkPrefix: "", MinLength: 6, MaxLength: 6},
+	{Code: "HT", CallingCode: 509, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "GP", CallingCode: 590, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "BO", CallingCode: 591, TrunkPrefix: "0", MinLength: 8, MaxLength: 8},
+	{Code: "GY", CallingCode: 592, TrunkPrefix: "", MinLength: 7, MaxLength: 7},
+	{Code: "EC", CallingCode: 593, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "GF", CallingCode: 594, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "PY", CallingCode: 595, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "MQ", CallingCode: 596, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "SR", CallingCode: 597, TrunkPrefix: "", MinLength: 6, MaxLength: 7},
+	{Code: "UY", CallingCode: 598, TrunkPrefix: "0", MinLength: 8, MaxLength: 8},
+	{Code: "CW", CallingCode: 599, TrunkPrefix: "", MinLength: 7, MaxLength: 8},
+	{Code: "TL", CallingCode: 670, TrunkPrefix: "", MinLength: 7, MaxLength: 8},
+	{Code: "NF", CallingCode: 672, TrunkPrefix: "", MinLength: 6, MaxLength: 6},
+	{Code: "BN", CallingCode: 673, TrunkPrefix: "", MinLength: 7, MaxLength: 7},
+	{Code: "NR", CallingCode: 674, TrunkPrefix: "", MinLength: 7, MaxLength: 7},
+	{Code: "PG", CallingCode: 675, TrunkPrefix: "", MinLength: 7, MaxLength: 8},
+	{Code: "TO", CallingCode: 676, TrunkPrefix: "", MinLength: 5, MaxLength: 7},
+	{Code: "SB", CallingCode: 677, TrunkPrefix: "", MinLength: 5, MaxLength: 7},
+	{Code: "VU", CallingCode: 678, TrunkPrefix: "", MinLength: 5, MaxLength: 7},
+	{Code: "FJ", CallingCode: 679, TrunkPrefix: "", MinLength: 7, MaxLength: 7},
+	{Code: "PW", CallingCode: 680, TrunkPrefix: "", MinLength: 7, MaxLength: 7},
+	{Code: "WF", CallingCode: 681, TrunkPrefix: "", MinLength: 6, MaxLength: 6},
+	{Code: "CK", CallingCode: 682, TrunkPrefix: "", MinLength: 5, MaxLength: 5},
+	{Code: "NU", CallingCode: 683, TrunkPrefix: "", MinLength: 4, MaxLength: 4},
+	{Code: "WS", CallingCode: 685, TrunkPrefix: "", MinLength: 5, MaxLength: 7},
+	{Code: "KI", CallingCode: 686, TrunkPrefix: "", MinLength: 5, MaxLength: 8},
+	{Code: "NC", CallingCode: 687, TrunkPrefix: "", MinLength: 6, MaxLength: 6},
+	{Code: "TV", CallingCode: 688, TrunkPrefix: "", MinLength: 5, MaxLength: 6},
+	{Code: "PF", CallingCode: 689, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "TK", CallingCode: 690, TrunkPrefix: "", MinLength: 4, MaxLength: 4},
+	{Code: "FM", CallingCode: 691, TrunkPrefix: "", MinLength: 7, MaxLength: 7},
+	{Code: "MH", CallingCode: 692, TrunkPrefix: "1", MinLength: 7, MaxLength: 7},
+	{Code: "KP", CallingCode: 850, TrunkPrefix: "0", MinLength: 8, MaxLength: 10},
+	{Code: "HK", CallingCode: 852, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "MO", CallingCode: 853, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "KH", CallingCode: 855, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "LA", CallingCode: 856, TrunkPrefix: "0", MinLength: 8, MaxLength: 10},
+	{Code: "BD", CallingCode: 880, TrunkPrefix: "0", MinLength: 8, MaxLength: 10},
+	{Code: "TW", CallingCode: 886, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "MV", CallingCode: 960, TrunkPrefix: "", MinLength: 7, MaxLength: 7},
+	{Code: "LB", CallingCode: 961, TrunkPrefix: "0", MinLength: 7, MaxLength: 8},
+	{Code: "JO", CallingCode: 962, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "SY", CallingCode: 963, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "IQ", CallingCode: 964, TrunkPrefix: "0", MinLength: 8, MaxLength: 10},
+	{Code: "KW", CallingCode: 965, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "SA", CallingCode: 966, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "YE", CallingCode: 967, TrunkPrefix: "0", MinLength: 7, MaxLength: 9},
+	{Code: "OM", CallingCode: 968, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "PS", CallingCode: 970, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "AE", CallingCode: 971, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "IL", CallingCode: 972, TrunkPrefix: "0", MinLength: 8, MaxLength: 9},
+	{Code: "BH", CallingCode: 973, TrunkPrefix: "", MinLength: 8, MaxLength: 8},
+	{Code: "QA", CallingCode: 974, TrunkPrefix: "", MinLength: 7, MaxLength: 8},
+	{Code: "BT", CallingCode: 975, TrunkPrefix: "", MinLength: 7, MaxLength: 8},
+	{Code: "MN", CallingCode: 976, TrunkPrefix: "0", MinLength: 8, MaxLength: 8},
+	{Code: "NP", CallingCode: 977, TrunkPrefix: "0", MinLength: 8, MaxLength: 10},
+	{Code: "TJ", CallingCode: 992, TrunkPrefix: "8", MinLength: 9, MaxLength: 9},
+	{Code: "TM", CallingCode: 993, TrunkPrefix: "8", MinLength: 8, MaxLength: 8},
+	{Code: "AZ", CallingCode: 994, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "GE", CallingCode: 995, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "KG", CallingCode: 996, TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	{Code: "UZ", CallingCode: 998, TrunkPrefix: "8", MinLength: 9, MaxLength: 9},
+}
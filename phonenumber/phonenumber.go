@@ -0,0 +1,309 @@
+package phonenumber
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrEmpty = errors.New("phone number is empty")
+var ErrInvalidCharacters = errors.New("phone number contains invalid characters")
+var ErrUnknownCallingCode = errors.New("phone number has unknown country calling code")
+var ErrUnknownRegion = errors.New("unknown region")
+var ErrInvalidLength = errors.New("phone number has invalid length")
+var ErrMissingRegion = errors.New("phone number is in national format and no region was given")
+
+const maxE164Digits = 15
+
+type Region struct {
+	Code        string
+	Name        string
+	CallingCode int
+	TrunkPrefix string
+	MinLength   int
+	MaxLength   int
+}
+
+type PhoneNumber struct {
+	CallingCode    int
+	Region         string
+	NationalNumber string
+}
+
+var regionsByCode = map[string]Region{}
+var regionsByCallingCode = map[int][]Region{}
+
+func init() {
+	for index := range regions {
+		regions[index].Name = regionNames[regions[index].Code]
+	}
+
+	for _, region := range regions {
+		regionsByCode[region.Code] = region
+		regionsByCallingCode[region.CallingCode] = append(regionsByCallingCode[region.CallingCode], region)
+	}
+}
+
+func GetRegion(code string) (Region, bool) {
+	region, ok := regionsByCode[strings.ToUpper(code)]
+	return region, ok
+}
+
+func CallingCodeForRegion(code string) (int, bool) {
+	region, ok := GetRegion(code)
+	return region.CallingCode, ok
+}
+
+func RegionsForCallingCode(callingCode int) []string {
+	codes := []string{}
+
+	for _, region := range regionsByCallingCode[callingCode] {
+		codes = append(codes, region.Code)
+	}
+
+	return codes
+}
+
+func Parse(number string, defaultRegion string) (*PhoneNumber, error) {
+	digits, international, err := clean(number)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if international {
+		return parseInternational(digits)
+	}
+
+	if defaultRegion == "" {
+		if strings.HasPrefix(digits, "0") {
+			return nil, ErrMissingRegion
+		}
+
+		return parseInternational(digits)
+	}
+
+	region, ok := GetRegion(defaultRegion)
+
+	if !ok {
+		return nil, ErrUnknownRegion
+	}
+
+	if idd := internationalPrefix(region); strings.HasPrefix(digits, idd) {
+		return parseInternational(digits[len(idd):])
+	}
+
+	national := stripTrunkPrefix(digits, region)
+
+	if len(national) > region.MaxLength && strings.HasPrefix(digits, strconv.Itoa(region.CallingCode)) {
+		return parseInternational(digits)
+	}
+
+	return build(region.CallingCode, national)
+}
+
+func Normalize(number string, defaultRegion string) (string, error) {
+	parsed, err := Parse(number, defaultRegion)
+
+	if err != nil {
+		return "", err
+	}
+
+	return parsed.E164(), nil
+}
+
+func IsValid(number string, defaultRegion string) bool {
+	_, err := Parse(number, defaultRegion)
+	return err == nil
+}
+
+func (p PhoneNumber) E164() string {
+	return fmt.Sprintf("+%d%s", p.CallingCode, p.NationalNumber)
+}
+
+func (p PhoneNumber) Digits() string {
+	return fmt.Sprintf("%d%s", p.CallingCode, p.NationalNumber)
+}
+
+func (p PhoneNumber) String() string {
+	return p.E164()
+}
+
+func (p PhoneNumber) International() string {
+	return fmt.Sprintf("+%d %s", p.CallingCode, p.groupNational())
+}
+
+func (p PhoneNumber) National() string {
+	if p.CallingCode == 1 && len(p.NationalNumber) == 10 {
+		return fmt.Sprintf("(%s) %s-%s", p.NationalNumber[:3], p.NationalNumber[3:6], p.NationalNumber[6:])
+	}
+
+	region, ok := GetRegion(p.Region)
+
+	if ok && region.TrunkPrefix != "" {
+		return region.TrunkPrefix + p.groupNational()
+	}
+
+	return p.groupNational()
+}
+
+func (p PhoneNumber) groupNational() string {
+	number := p.NationalNumber
+
+	if p.CallingCode == 1 && len(number) == 10 {
+		return number[:3] + "-" + number[3:6] + "-" + number[6:]
+	}
+
+	if len(number) <= 4 {
+		return number
+	}
+
+	groups := []string{}
+	head := len(number) % 3
+
+	if head == 1 {
+		head = 4
+	}
+
+	if head > 0 {
+		groups = append(groups, number[:head])
+		number = number[head:]
+	}
+
+	for len(number) > 0 {
+		groups = append(groups, number[:3])
+		number = number[3:]
+	}
+
+	return strings.Join(groups, " ")
+}
+
+func clean(number string) (string, bool, error) {
+	number = strings.TrimSpace(number)
+
+	if number == "" {
+		return "", false, ErrEmpty
+	}
+
+	var builder strings.Builder
+	international := false
+
+	for index, char := range number {
+		switch {
+		case char >= '0' && char <= '9':
+			builder.WriteRune(char)
+		case char == '+' && builder.Len() == 0 && !international:
+			international = true
+		case char == ' ' || char == '-' || char == '.' || char == '(' || char == ')' || char == '/' || char == '\u00a0':
+		default:
+			if index > 0 && isExtensionStart(number[index:]) {
+				return finish(builder.String(), international)
+			}
+			return "", false, ErrInvalidCharacters
+		}
+	}
+
+	return finish(builder.String(), international)
+}
+
+func finish(digits string, international bool) (string, bool, error) {
+	if digits == "" {
+		return "", false, ErrEmpty
+	}
+
+	return digits, international, nil
+}
+
+func isExtensionStart(rest string) bool {
+	lower := strings.ToLower(rest)
+
+	for _, prefix := range []string{"ext", "x", "#"} {
+		if strings.HasPrefix(lower, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseInternational(digits string) (*PhoneNumber, error) {
+	if strings.HasPrefix(digits, "0") {
+		return nil, ErrUnknownCallingCode
+	}
+
+	err := ErrUnknownCallingCode
+
+	for length := min(3, len(digits)-1); length >= 1; length-- {
+		callingCode, _ := strconv.Atoi(digits[:length])
+
+		if _, ok := regionsByCallingCode[callingCode]; !ok {
+			continue
+		}
+
+		parsed, buildErr := build(callingCode, digits[length:])
+
+		if buildErr == nil {
+			return parsed, nil
+		}
+
+		err = buildErr
+	}
+
+	return nil, err
+}
+
+func build(callingCode int, national string) (*PhoneNumber, error) {
+	candidates := regionsByCallingCode[callingCode]
+
+	if len(candidates) == 0 {
+		return nil, ErrUnknownCallingCode
+	}
+
+	if len(strconv.Itoa(callingCode))+len(national) > maxE164Digits {
+		return nil, ErrInvalidLength
+	}
+
+	for _, region := range candidates {
+		if len(national) >= region.MinLength && len(national) <= region.MaxLength {
+			return &PhoneNumber{CallingCode: callingCode, Region: regionFor(callingCode, national, region), NationalNumber: national}, nil
+		}
+	}
+
+	return nil, ErrInvalidLength
+}
+
+func regionFor(callingCode int, national string, fallback Region) string {
+	if callingCode == 7 && len(national) > 0 && (national[0] == '6' || national[0] == '7') {
+		return "KZ"
+	}
+
+	return fallback.Code
+}
+
+func stripTrunkPrefix(digits string, region Region) string {
+	if region.TrunkPrefix == "" || !strings.HasPrefix(digits, region.TrunkPrefix) {
+		return digits
+	}
+
+	national := digits[len(region.TrunkPrefix):]
+
+	if len(national) < region.MinLength {
+		return digits
+	}
+
+	return national
+}
+
+func internationalPrefix(region Region) string {
+	switch region.CallingCode {
+	case 1:
+		return "011"
+	case 61:
+		return "0011"
+	case 81:
+		return "010"
+	}
+
+	return "00"
+}
@@ -0,0 +1,104 @@
+package phonenumber
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		number        string
+		defaultRegion string
+		e164          string
+		region        string
+		err           error
+	}{
+		{name: "international with formatting", number: "+1 (415) 555-0100", e164: "+14155550100", region: "US"},
+		{name: "bare international digits", number: "14155550100", e164: "+14155550100", region: "US"},
+		{name: "bare german digits", number: "4930123456", e164: "+4930123456", region: "DE"},
+		{name: "bare british digits", number: "442071234567", e164: "+442071234567", region: "GB"},
+		{name: "explicit egyptian number", number: "+20 7123 4567", e164: "+2071234567", region: "EG"},
+		{name: "national format without region", number: "020 7123 4567", err: ErrMissingRegion},
+		{name: "national digits without region", number: "02071234567", err: ErrMissingRegion},
+		{name: "international prefix without region", number: "0049 30 123456", err: ErrMissingRegion},
+		{name: "national format with region", number: "020 7123 4567", defaultRegion: "GB", e164: "+442071234567", region: "GB"},
+		{name: "international prefix with region", number: "0049 30 123456", defaultRegion: "DE", e164: "+4930123456", region: "DE"},
+		{name: "north american international prefix", number: "011 44 20 7123 4567", defaultRegion: "US", e164: "+442071234567", region: "GB"},
+		{name: "national number with trunk prefix", number: "1 415 555 0100", defaultRegion: "US", e164: "+14155550100", region: "US"},
+		{name: "kazakhstan range", number: "+7 701 234 5678", e164: "+77012345678", region: "KZ"},
+		{name: "extension is dropped", number: "+1 415 555 0100 ext 12", e164: "+14155550100", region: "US"},
+		{name: "invalid length for the only matching code", number: "5550100000", err: ErrInvalidLength},
+		{name: "unknown calling code", number: "+999 1234 5678", err: ErrUnknownCallingCode},
+		{name: "leading zero after plus", number: "+0 123 4567", err: ErrUnknownCallingCode},
+		{name: "letters", number: "+1 415 CALL NOW", err: ErrInvalidCharacters},
+		{name: "empty", number: "  ", err: ErrEmpty},
+		{name: "unknown region", number: "415 555 0100", defaultRegion: "XX", err: ErrUnknownRegion},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parsed, err := Parse(test.number, test.defaultRegion)
+
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Fatalf("Parse(%q, %q) error = %v, want %v", test.number, test.defaultRegion, err, test.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Parse(%q, %q) unexpected error: %v", test.number, test.defaultRegion, err)
+			}
+
+			if parsed.E164() != test.e164 || parsed.Region != test.region {
+				t.Fatalf("Parse(%q, %q) = %s (%s), want %s (%s)", test.number, test.defaultRegion, parsed.E164(), parsed.Region, test.e164, test.region)
+			}
+		})
+	}
+}
+
+func TestParseInternationalPrefersLongestValidCallingCode(t *testing.T) {
+	regionsByCallingCode[2] = []Region{{Code: "ZZ", CallingCode: 2, MinLength: 9, MaxLength: 9}}
+	defer delete(regionsByCallingCode, 2)
+
+	parsed, err := parseInternational("2071234567")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if parsed.CallingCode != 20 || parsed.NationalNumber != "71234567" {
+		t.Fatalf("parseInternational picked +%d %s, want +20 71234567", parsed.CallingCode, parsed.NationalNumber)
+	}
+
+	parsed, err = parseInternational("2123456789")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if parsed.CallingCode != 2 {
+		t.Fatalf("parseInternational picked +%d, want +2 when longer codes have invalid length", parsed.CallingCode)
+	}
+}
+
+func TestFormatting(t *testing.T) {
+	parsed, err := Parse("+14155550100", "")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if parsed.Digits() != "14155550100" {
+		t.Errorf("Digits() = %q", parsed.Digits())
+	}
+
+	if parsed.International() != "+1 415-555-0100" {
+		t.Errorf("International() = %q", parsed.International())
+	}
+
+	if parsed.National() != "(415) 555-0100" {
+		t.Errorf("National() = %q", parsed.National())
+	}
+}
@@ -1,6 +1,8 @@
 package wavix
 
 import (
+	"time"
+
 	"github.com/wavix/sdk-go/utils"
 )
 
@@ -41,7 +43,7 @@ type GetCustomerInfoResponse struct {
 }
 
 type GetAccountSettingsResponse struct {
-	Balance      string                      `json:"balance"`
+	Balance      utils.Money                 `json:"balance"`
 	GlobalLimits AccountSettingsGlobalLimits `json:"global_limits"`
 }
 
@@ -78,3 +80,19 @@ func (s *ProfileService) UpdateCustomerInfo(payload UpdateCustomerInfoPayload) (
 func (s *ProfileService) GetAccountSettings() (*GetAccountSettingsResponse, *utils.HttpErrorResponse) {
 	return utils.Get[GetAccountSettingsResponse](*s.httpConfig, "/v1/profile/config", GetAccountSettingsResponse{})
 }
+
+func GetProfileTimezone(profile ProfileServiceInterface) (*time.Location, *utils.HttpErrorResponse) {
+	info, err := profile.GetCustomerInfo()
+
+	if err != nil {
+		return nil, err
+	}
+
+	location, parseErr := utils.ParseTimezone(info.Timezone)
+
+	if parseErr != nil {
+		return nil, &utils.HttpErrorResponse{Message: parseErr.Error()}
+	}
+
+	return location, nil
+}
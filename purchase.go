@@ -0,0 +1,362 @@
+package wavix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+type PurchaseStage string
+
+const (
+	InventoryPurchaseStage PurchaseStage = "inventory"
+	CartPurchaseStage      PurchaseStage = "cart"
+	CheckoutPurchaseStage  PurchaseStage = "checkout"
+	DocumentsPurchaseStage PurchaseStage = "documents"
+	RoutingPurchaseStage   PurchaseStage = "routing"
+)
+
+var didDocumentIdsByName = map[string]DidDocumentId{
+	"general":       DidDocumentIdGeneral,
+	"address":       DidDocumentAddress,
+	"local_address": DidDocumentLocalAddress,
+}
+
+type PurchaseSpec struct {
+	CountryId       int
+	RegionId        int
+	CityId          int
+	AreaCode        int
+	Count           int
+	SmsEnabled      bool
+	MaxMonthlyFee   *utils.Money
+	TypeFilter      string
+	Destinations    []DidDestinationPayload
+	SmsRelayUrl     string
+	Documents       map[DidDocumentId]DidDocumentFile
+	ReturnOnFailure bool
+}
+
+type PurchaseResult struct {
+	City       City
+	Cart       []CartDidItem
+	Dids       []DidItem
+	Documents  map[DidDocumentId][]string
+	RolledBack bool
+	Returned   bool
+}
+
+type PurchaseError struct {
+	Stage PurchaseStage
+	Err   error
+}
+
+func (e *PurchaseError) Error() string {
+	return fmt.Sprintf("purchase failed at %s: %v", e.Stage, e.Err)
+}
+
+func (e *PurchaseError) Unwrap() error {
+	return e.Err
+}
+
+type Purchaser struct {
+	buy     BuyServiceInterface
+	cart    CartServiceInterface
+	did     DidServiceInterface
+	profile ProfileServiceInterface
+}
+
+func NewPurchaser(buy BuyServiceInterface, cart CartServiceInterface, did DidServiceInterface, profile ProfileServiceInterface) *Purchaser {
+	return &Purchaser{buy: buy, cart: cart, did: did, profile: profile}
+}
+
+func (i *Instance) Purchase(ctx context.Context, spec PurchaseSpec) (*PurchaseResult, error) {
+	return NewPurchaser(i.Buy, i.Cart, i.Did, i.Profile).Purchase(ctx, spec)
+}
+
+func (p *Purchaser) Purchase(ctx context.Context, spec PurchaseSpec) (*PurchaseResult, error) {
+	if spec.CountryId == 0 {
+		return nil, &PurchaseError{Stage: InventoryPurchaseStage, Err: errors.New("country is required")}
+	}
+
+	if spec.CityId == 0 && spec.AreaCode == 0 {
+		return nil, &PurchaseError{Stage: InventoryPurchaseStage, Err: errors.New("city or area code is required")}
+	}
+
+	if spec.Count <= 0 {
+		spec.Count = 1
+	}
+
+	if len(spec.Destinations) > 0 {
+		if err := utils.GetValidate().Var(spec.Destinations, "dive"); err != nil {
+			return nil, &PurchaseError{Stage: RoutingPurchaseStage, Err: err}
+		}
+	}
+
+	result := &PurchaseResult{Documents: map[DidDocumentId][]string{}}
+
+	city, err := p.resolveCity(spec)
+	if err != nil {
+		return nil, &PurchaseError{Stage: InventoryPurchaseStage, Err: err}
+	}
+	result.City = *city
+
+	candidates, err := p.findInventory(ctx, spec, city.Id)
+	if err != nil {
+		return result, &PurchaseError{Stage: InventoryPurchaseStage, Err: err}
+	}
+
+	ids := make([]string, len(candidates))
+	for index, candidate := range candidates {
+		ids[index] = strconv.Itoa(candidate.Id)
+	}
+
+	added, httpErr := p.cart.AddDidToCart(ids)
+	if httpErr != nil {
+		result.RolledBack = p.rollbackCart(ids)
+		return result, &PurchaseError{Stage: CartPurchaseStage, Err: httpErr}
+	}
+	result.Cart = *added
+
+	if err := ctx.Err(); err != nil {
+		result.RolledBack = p.rollbackCart(ids)
+		return result, &PurchaseError{Stage: CartPurchaseStage, Err: err}
+	}
+
+	checkout, _, httpErr := CheckoutIfAffordable(p.cart, p.profile, ids)
+	if httpErr != nil || !checkout.Success {
+		result.RolledBack = p.rollbackCart(ids)
+
+		if httpErr != nil {
+			return result, &PurchaseError{Stage: CheckoutPurchaseStage, Err: httpErr}
+		}
+
+		return result, &PurchaseError{Stage: CheckoutPurchaseStage, Err: errors.New("checkout was not successful")}
+	}
+
+	dids, err := p.findPurchasedDids(candidates)
+	result.Dids = dids
+	if err != nil {
+		return result, p.failAfterCheckout(result, spec, &PurchaseError{Stage: CheckoutPurchaseStage, Err: err})
+	}
+
+	if err := p.uploadDocuments(result, spec, candidates); err != nil {
+		return result, p.failAfterCheckout(result, spec, err)
+	}
+
+	if err := p.configureRouting(spec, dids); err != nil {
+		return result, p.failAfterCheckout(result, spec, err)
+	}
+
+	return result, nil
+}
+
+func (p *Purchaser) resolveCity(spec PurchaseSpec) (*City, error) {
+	cities := []City{}
+
+	if spec.RegionId != 0 {
+		response, httpErr := p.buy.GetRegionCitiesList(spec.CountryId, spec.RegionId)
+
+		if httpErr != nil {
+			return nil, httpErr
+		}
+
+		cities = response.Cities
+	} else {
+		catalogCities, err := NewCatalog(p.buy).countryCities(spec.CountryId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, city := range catalogCities {
+			cities = append(cities, city.City)
+		}
+	}
+
+	for _, city := range cities {
+		if (spec.CityId != 0 && city.Id == spec.CityId) || (spec.CityId == 0 && city.AreaCode == spec.AreaCode) {
+			return &city, nil
+		}
+	}
+
+	if spec.CityId != 0 {
+		return nil, fmt.Errorf("city %d not found", spec.CityId)
+	}
+
+	return nil, fmt.Errorf("no city with area code %d", spec.AreaCode)
+}
+
+func (p *Purchaser) findInventory(ctx context.Context, spec PurchaseSpec, cityId int) ([]CartDidItem, error) {
+	candidates := []CartDidItem{}
+	params := GetAvailableDidsQueryParams{TextEnabledOnly: spec.SmsEnabled, TypeFilter: spec.TypeFilter}
+	params.PerPage = 100
+	dids := newAvailableDidsPager(p.buy, spec.CountryId, cityId, params)
+	matcher, err := newDidSearchMatcher(DidSearchQuery{CountryId: spec.CountryId, SmsEnabled: spec.SmsEnabled, MaxMonthlyFee: spec.MaxMonthlyFee, TypeFilter: spec.TypeFilter})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for len(candidates) < spec.Count {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if !dids.Next() {
+			break
+		}
+
+		item := dids.Item()
+
+		if _, ok := matcher.score(item); ok && purchaseDocumentsAvailable(spec, item) {
+			candidates = append(candidates, item)
+		}
+	}
+
+	if err := dids.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(candidates) < spec.Count {
+		return nil, fmt.Errorf("found %d of %d matching numbers", len(candidates), spec.Count)
+	}
+
+	return candidates, nil
+}
+
+func purchaseDocumentsAvailable(spec PurchaseSpec, item CartDidItem) bool {
+	for _, name := range item.RequireDocs {
+		docId, ok := didDocumentIdFor(name)
+
+		if !ok {
+			return false
+		}
+
+		if _, ok := spec.Documents[docId]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func didDocumentIdFor(name string) (DidDocumentId, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	if id, err := strconv.Atoi(name); err == nil {
+		return DidDocumentId(id), true
+	}
+
+	docId, ok := didDocumentIdsByName[strings.NewReplacer(" ", "_", "-", "_").Replace(name)]
+	return docId, ok
+}
+
+func (p *Purchaser) findPurchasedDids(candidates []CartDidItem) ([]DidItem, error) {
+	dids := []DidItem{}
+	missing := []string{}
+
+	for _, candidate := range candidates {
+		response, httpErr := p.did.GetAccountDids(GetAccountDidsQueryParams{Search: candidate.Number})
+		if httpErr != nil {
+			return dids, httpErr
+		}
+
+		found := false
+		for _, item := range response.Items {
+			if phoneDigits(item.Number) == phoneDigits(candidate.Number) {
+				dids = append(dids, item)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			missing = append(missing, candidate.Number)
+		}
+	}
+
+	if len(missing) > 0 {
+		return dids, fmt.Errorf("purchased numbers not found on account: %s", strings.Join(missing, ", "))
+	}
+
+	return dids, nil
+}
+
+func (p *Purchaser) uploadDocuments(result *PurchaseResult, spec PurchaseSpec, candidates []CartDidItem) error {
+	didIds := map[DidDocumentId][]string{}
+	purchased := map[string]DidItem{}
+
+	for _, did := range result.Dids {
+		purchased[phoneDigits(did.Number)] = did
+	}
+
+	for _, candidate := range candidates {
+		did, ok := purchased[phoneDigits(candidate.Number)]
+
+		if !ok {
+			return &PurchaseError{Stage: DocumentsPurchaseStage, Err: fmt.Errorf("purchased number %s not found on account", candidate.Number)}
+		}
+
+		for _, name := range candidate.RequireDocs {
+			docId, _ := didDocumentIdFor(name)
+			didIds[docId] = append(didIds[docId], strconv.Itoa(did.Id))
+		}
+	}
+
+	for docId, ids := range didIds {
+		_, httpErr := p.did.UploadDidDocument(UploadDidDocumentPayload{DidIds: ids, File: spec.Documents[docId], DocId: int(docId)})
+		if httpErr != nil {
+			return &PurchaseError{Stage: DocumentsPurchaseStage, Err: httpErr}
+		}
+
+		result.Documents[docId] = ids
+	}
+
+	return nil
+}
+
+func (p *Purchaser) configureRouting(spec PurchaseSpec, dids []DidItem) error {
+	if len(spec.Destinations) == 0 && spec.SmsRelayUrl == "" {
+		return nil
+	}
+
+	ids := make([]int, len(dids))
+	for index, did := range dids {
+		ids[index] = did.Id
+	}
+
+	_, httpErr := p.did.UpdateDidDestinations(UpdateDidDestinationsPayload{Ids: ids, SmsRelayUrl: spec.SmsRelayUrl, Destinations: spec.Destinations})
+	if httpErr != nil {
+		return &PurchaseError{Stage: RoutingPurchaseStage, Err: httpErr}
+	}
+
+	return nil
+}
+
+func (p *Purchaser) rollbackCart(ids []string) bool {
+	_, httpErr := RemoveDidsFromCart(p.cart, ids)
+	return httpErr == nil
+}
+
+func (p *Purchaser) failAfterCheckout(result *PurchaseResult, spec PurchaseSpec, err error) error {
+	if !spec.ReturnOnFailure || len(result.Dids) == 0 {
+		return err
+	}
+
+	ids := make([]string, len(result.Dids))
+	for index, did := range result.Dids {
+		ids[index] = strconv.Itoa(did.Id)
+	}
+
+	if _, httpErr := p.did.ReturnDidsToStock(ids); httpErr != nil {
+		return errors.Join(err, httpErr)
+	}
+
+	result.Returned = true
+	return err
+}
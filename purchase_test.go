@@ -0,0 +1,48 @@
+package wavix
+
+import (
+	"testing"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+type fakeBuyService struct {
+	catalog *Catalog
+}
+
+func (f *fakeBuyService) GetCountryList() (*GetCountryListResponse, *utils.HttpErrorResponse) {
+	return &GetCountryListResponse{Countries: f.catalog.countries}, nil
+}
+
+func (f *fakeBuyService) GetRegionList(countryId int) (*GetRegionListResponse, *utils.HttpErrorResponse) {
+	return &GetRegionListResponse{Regions: f.catalog.regions[countryId]}, nil
+}
+
+func (f *fakeBuyService) GetCountryCitiesList(countryId int) (*GetCityListResponse, *utils.HttpErrorResponse) {
+	return &GetCityListResponse{Cities: f.catalog.cities[catalogCitiesKey(countryId, 0)]}, nil
+}
+
+func (f *fakeBuyService) GetRegionCitiesList(countryId int, regionId int) (*GetCityListResponse, *utils.HttpErrorResponse) {
+	return &GetCityListResponse{Cities: f.catalog.cities[catalogCitiesKey(countryId, regionId)]}, nil
+}
+
+func (f *fakeBuyService) GetAvailableDids(countryId int, cityId int, queryParams GetAvailableDidsQueryParams) (*GetAvailableDidsPaginatedResponse, *utils.HttpErrorResponse) {
+	return &GetAvailableDidsPaginatedResponse{}, nil
+}
+
+func TestResolveCityByAreaCodeWithoutRegion(t *testing.T) {
+	purchaser := NewPurchaser(&fakeBuyService{catalog: testCatalog()}, nil, nil, nil)
+
+	city, err := purchaser.resolveCity(PurchaseSpec{CountryId: 1, AreaCode: 415})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if city.Id != 101 {
+		t.Fatalf("got city %+v", city)
+	}
+
+	if _, err := purchaser.resolveCity(PurchaseSpec{CountryId: 1, AreaCode: 999}); err == nil {
+		t.Fatal("expected an error for an unknown area code")
+	}
+}
@@ -40,7 +40,7 @@ type SipTrunkListItem struct {
 	HostRequest             *HostRequest `json:"host_request"`
 	AuthMethod              string       `json:"auth_method"`
 	CallerId                string       `json:"callerid"`
-	Charge                  string       `json:"charge"`
+	Charge                  utils.Money  `json:"charge"`
 	Label                   string       `json:"label"`
 	Name                    string       `json:"name"`
 	Status                  string       `json:"status"`
@@ -57,52 +57,52 @@ type AllowedIps struct {
 }
 
 type SipTrunkConfigurationItem struct {
-	Id                      int          `json:"id"`
-	MaxChannels             int          `json:"max_channels"`
-	CallLimit               int          `json:"call_limit"`
-	TranscriptionThreshold  int          `json:"transcription_threshold"`
-	CreatedAt               string       `json:"created_at"`
-	Name                    string       `json:"string"`
-	CallerId                string       `json:"callerid"`
-	Label                   string       `json:"label"`
-	AuthMethod              string       `json:"auth_method"`
-	Host                    string       `json:"host"`
-	RewritePrefix           string       `json:"rewrite_prefix"`
-	RewriteCond             string       `json:"rewrite_cond"`
-	MaxCallCost             string       `json:"max_call_cost"`
-	AllowedIps              []AllowedIps `json:"allowed_ips"`
-	CallRestrict            bool         `json:"call_restrict"`
-	ChannelsRestrict        bool         `json:"channels_restrict"`
-	IpRestrict              bool         `json:"ip_restrict"`
-	CostLimit               bool         `json:"cost_limit"`
-	RewriteEnabled          bool         `json:"rewrite_enabled"`
-	CallRecordingEnabled    bool         `json:"call_recording_enabled"`
-	MachineDetectionEnabled bool         `json:"machine_detection_enabled"`
-	DidInfoEnabled          bool         `json:"didinfo_enabled"`
-	TranscriptionEnabled    bool         `json:"transcription_enabled"`
+	Id                      int             `json:"id"`
+	MaxChannels             int             `json:"max_channels"`
+	CallLimit               int             `json:"call_limit"`
+	TranscriptionThreshold  int             `json:"transcription_threshold"`
+	CreatedAt               utils.Timestamp `json:"created_at"`
+	Name                    string          `json:"string"`
+	CallerId                string          `json:"callerid"`
+	Label                   string          `json:"label"`
+	AuthMethod              string          `json:"auth_method"`
+	Host                    string          `json:"host"`
+	RewritePrefix           string          `json:"rewrite_prefix"`
+	RewriteCond             string          `json:"rewrite_cond"`
+	MaxCallCost             utils.Money     `json:"max_call_cost"`
+	AllowedIps              []AllowedIps    `json:"allowed_ips"`
+	CallRestrict            bool            `json:"call_restrict"`
+	ChannelsRestrict        bool            `json:"channels_restrict"`
+	IpRestrict              bool            `json:"ip_restrict"`
+	CostLimit               bool            `json:"cost_limit"`
+	RewriteEnabled          bool            `json:"rewrite_enabled"`
+	CallRecordingEnabled    bool            `json:"call_recording_enabled"`
+	MachineDetectionEnabled bool            `json:"machine_detection_enabled"`
+	DidInfoEnabled          bool            `json:"didinfo_enabled"`
+	TranscriptionEnabled    bool            `json:"transcription_enabled"`
 }
 
 type CreateSipTrunkPayload struct {
-	Label                   string   `validate:"required" json:"label,omitempty"`
-	Password                string   `validate:"required" json:"password"`
-	CallerId                string   `validate:"required" json:"callerid"`
-	MaxCallCost             string   `validate:"required" json:"max_call_cost"`
-	Host                    string   `json:"host,omitempty"`
-	RewritePrefix           string   `json:"rewrite_prefix,omitempty"`
-	RewriteCond             string   `json:"rewrite_cond,omitempty"`
-	AllowedIps              []string `json:"allowed_ips,omitempty"`
-	MaxChannels             int      `json:"max_channels,omitempty"`
-	CallLimit               int      `json:"call_limit,omitempty"`
-	TranscriptionThreshold  int      `json:"transcription_threshold"`
-	CostLimit               bool     `json:"cost_limit"`
-	IpRestrict              bool     `json:"ip_restrict"`
-	ChannelsRestrict        bool     `json:"channels_restrict"`
-	CallRestrict            bool     `json:"call_restrict"`
-	DidInfoEnabled          bool     `json:"didinfo_enabled"`
-	TranscriptionEnabled    bool     `json:"transcription_enabled"`
-	RewriteEnabled          bool     `json:"rewrite_enabled"`
-	MachineDetectionEnabled bool     `json:"machine_detection_enabled,omitempty"`
-	CallRecordingEnabled    bool     `json:"call_recording_enabled,omitempty"`
+	Label                   string      `validate:"required" json:"label,omitempty"`
+	Password                string      `validate:"required" json:"password"`
+	CallerId                string      `validate:"required" json:"callerid"`
+	MaxCallCost             utils.Money `validate:"required" json:"max_call_cost"`
+	Host                    string      `json:"host,omitempty"`
+	RewritePrefix           string      `json:"rewrite_prefix,omitempty"`
+	RewriteCond             string      `json:"rewrite_cond,omitempty"`
+	AllowedIps              []string    `json:"allowed_ips,omitempty"`
+	MaxChannels             int         `json:"max_channels,omitempty"`
+	CallLimit               int         `json:"call_limit,omitempty"`
+	TranscriptionThreshold  int         `json:"transcription_threshold"`
+	CostLimit               bool        `json:"cost_limit"`
+	IpRestrict              bool        `json:"ip_restrict"`
+	ChannelsRestrict        bool        `json:"channels_restrict"`
+	CallRestrict            bool        `json:"call_restrict"`
+	DidInfoEnabled          bool        `json:"didinfo_enabled"`
+	TranscriptionEnabled    bool        `json:"transcription_enabled"`
+	RewriteEnabled          bool        `json:"rewrite_enabled"`
+	MachineDetectionEnabled bool        `json:"machine_detection_enabled,omitempty"`
+	CallRecordingEnabled    bool        `json:"call_recording_enabled,omitempty"`
 }
 type UpdateSipTrunkPayload = CreateSipTrunkPayload
 
@@ -1,6 +1,11 @@
 package wavix
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
 	"github.com/wavix/sdk-go/utils"
 )
 
@@ -8,43 +13,171 @@ type SmsServiceInterface interface {
 	SendMessage(payload SendMessagePayload) (*MessageResponseBody, *utils.HttpErrorResponse)
 }
 
+type SmsHistoryServiceInterface interface {
+	GetMessage(messageId string) (*MessageResponseBody, *utils.HttpErrorResponse)
+	GetMessages(params GetMessagesQueryParams) (*utils.PaginationResponse[MessageResponseBody], *utils.HttpErrorResponse)
+}
+
+type MessageStatus string
+type MessageDirection string
+
+const (
+	AcceptedMessageStatus    MessageStatus = "accepted"
+	PendingMessageStatus     MessageStatus = "pending"
+	SentMessageStatus        MessageStatus = "sent"
+	DeliveredMessageStatus   MessageStatus = "delivered"
+	UndeliveredMessageStatus MessageStatus = "undelivered"
+	RejectedMessageStatus    MessageStatus = "rejected"
+	ExpiredMessageStatus     MessageStatus = "expired"
+	DlrExpiredMessageStatus  MessageStatus = "dlr_expired"
+	ReceivedMessageStatus    MessageStatus = "received"
+)
+
+const (
+	InboundMessageDirection  MessageDirection = "inbound"
+	OutboundMessageDirection MessageDirection = "outbound"
+)
+
 type MessageBody struct {
-	Text  string    `json:"text"`
-	Media *[]string `json:"media,omitempty"`
+	Text  string    `validate:"max=1600" json:"text"`
+	Media *[]string `validate:"omitempty,max=10,dive,required,http_url" json:"media,omitempty"`
 }
 
 type SendMessagePayload struct {
-	From        string      `json:"from"`
-	To          string      `json:"to"`
+	From        string      `validate:"required,sender_id" json:"from"`
+	To          string      `validate:"required,phone_number" json:"to"`
 	MessageBody MessageBody `json:"message_body"`
-	CallbackUrl *string     `json:"callback_url,omitempty"`
-	Validity    *int        `json:"validity,omitempty"`
-	ExternalId  *string     `json:"external_id,omitempty"`
+	CallbackUrl *string     `validate:"omitempty,http_url" json:"callback_url,omitempty"`
+	Validity    *int        `validate:"omitempty,min=60,max=259200" json:"validity,omitempty"`
+	ExternalId  *string     `validate:"omitempty,max=255" json:"external_id,omitempty"`
 }
 
 type MessageResponseBody struct {
-	Charge       string      `json:"charge"`
-	DeliveredAt  *string     `json:"delivered_at"`
-	Direction    string      `json:"direction"`
-	ErrorMessage *string     `json:"error_message"`
-	From         string      `json:"from"`
-	To           string      `json:"to"`
-	Mcc          string      `json:"mcc"`
-	Mnc          string      `json:"mnc"`
-	MessageBody  MessageBody `json:"message_body"`
-	MessageId    string      `json:"message_id"`
-	MessageType  string      `json:"message_type"`
-	Segments     int         `json:"segments"`
-	SentAt       *string     `json:"sent_at"`
-	Status       string      `json:"status"`
-	SubmittedAt  string      `json:"submitted_at"`
-	Tag          *string     `json:"tag"`
+	Charge       utils.Money      `json:"charge"`
+	DeliveredAt  *utils.Timestamp `json:"delivered_at"`
+	Direction    MessageDirection `json:"direction"`
+	ErrorMessage *string          `json:"error_message"`
+	From         string           `json:"from"`
+	To           string           `json:"to"`
+	Mcc          string           `json:"mcc"`
+	Mnc          string           `json:"mnc"`
+	MessageBody  MessageBody      `json:"message_body"`
+	MessageId    string           `json:"message_id"`
+	MessageType  string           `json:"message_type"`
+	Segments     int              `json:"segments"`
+	SentAt       *utils.Timestamp `json:"sent_at"`
+	Status       MessageStatus    `json:"status"`
+	SubmittedAt  utils.Timestamp  `json:"submitted_at"`
+	Tag          *string          `json:"tag"`
+	ExternalId   *string          `json:"external_id"`
+}
+
+type GetMessagesQueryParams struct {
+	utils.PaginationParams
+	utils.OptionalDateParams
+	Direction  MessageDirection `validate:"omitempty,oneof=inbound outbound" url:"type,omitempty"`
+	Status     MessageStatus    `validate:"omitempty,oneof=accepted pending sent delivered undelivered rejected expired dlr_expired received" url:"status,omitempty"`
+	FromSearch string           `url:"from_search,omitempty"`
+	ToSearch   string           `url:"to_search,omitempty"`
+	Tag        string           `url:"tag,omitempty"`
+	ExternalId string           `url:"external_id,omitempty"`
 }
 
 type SmsService struct {
 	httpConfig *utils.HttpConfig
 }
 
+func ValidateSendMessagePayload(payload SendMessagePayload) *utils.HttpErrorResponse {
+	validate := utils.GetJsonValidate()
+	validate.RegisterStructValidation(validateMessageBody, MessageBody{})
+
+	err := validate.Struct(payload)
+
+	if err != nil {
+		return utils.ValidationErrorResponse(err)
+	}
+
+	return nil
+}
+
+func validateMessageBody(sl validator.StructLevel) {
+	body := sl.Current().Interface().(MessageBody)
+
+	if strings.TrimSpace(body.Text) == "" && (body.Media == nil || len(*body.Media) == 0) {
+		sl.ReportError(body.Text, "text", "Text", "required_without", "media")
+	}
+}
+
+func normalizeSendMessagePayload(payload *SendMessagePayload) *utils.HttpErrorResponse {
+	errors := map[string]string{}
+
+	if from, err := normalizeSenderId(payload.From); err != nil {
+		errors["from"] = err.Error()
+	} else {
+		payload.From = from
+	}
+
+	if to, err := normalizePhoneNumber(payload.To); err != nil {
+		errors["to"] = err.Error()
+	} else {
+		payload.To = to
+	}
+
+	return phoneFieldsError(errors)
+}
+
 func (s *SmsService) SendMessage(payload SendMessagePayload) (*MessageResponseBody, *utils.HttpErrorResponse) {
+	if err := normalizeSendMessagePayload(&payload); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateSendMessagePayload(payload); err != nil {
+		return nil, err
+	}
+
 	return utils.Post[MessageResponseBody](*s.httpConfig, "/v2/messages", payload, MessageResponseBody{})
 }
+
+func (s *SmsService) GetMessage(messageId string) (*MessageResponseBody, *utils.HttpErrorResponse) {
+	if messageId == "" || messageId == "." || messageId == ".." {
+		return nil, &utils.HttpErrorResponse{Success: false, Message: "Validation failed", Errors: &map[string]string{"message_id": fmt.Sprintf("invalid message id %q", messageId)}}
+	}
+
+	path := fmt.Sprintf("/v2/messages/%s", url.PathEscape(messageId))
+
+	return utils.Get[MessageResponseBody](*s.httpConfig, path, MessageResponseBody{})
+}
+
+func (s *SmsService) GetMessages(params GetMessagesQueryParams) (*utils.PaginationResponse[MessageResponseBody], *utils.HttpErrorResponse) {
+	validate := utils.GetValidate()
+	err := validate.Struct(params)
+
+	if err != nil {
+		return nil, &utils.HttpErrorResponse{Message: err.Error()}
+	}
+
+	params.OptionalDateParams = params.OptionalDateParams.WithDefaultLocation(s.httpConfig.LocationResolver)
+	url := utils.BuildUrlWithQueryString("/v2/messages", params)
+
+	return utils.Get[utils.PaginationResponse[MessageResponseBody]](*s.httpConfig, url, utils.PaginationResponse[MessageResponseBody]{})
+}
+
+func GetMessage(sms SmsServiceInterface, messageId string) (*MessageResponseBody, *utils.HttpErrorResponse) {
+	history, ok := sms.(SmsHistoryServiceInterface)
+
+	if !ok {
+		return nil, unsupportedOperationError("GetMessage")
+	}
+
+	return history.GetMessage(messageId)
+}
+
+func GetMessages(sms SmsServiceInterface, params GetMessagesQueryParams) (*utils.PaginationResponse[MessageResponseBody], *utils.HttpErrorResponse) {
+	history, ok := sms.(SmsHistoryServiceInterface)
+
+	if !ok {
+		return nil, unsupportedOperationError("GetMessages")
+	}
+
+	return history.GetMessages(params)
+}
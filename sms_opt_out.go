@@ -0,0 +1,318 @@
+package wavix
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+type OptOutKeywordType string
+
+const (
+	StopOptOutKeywordType  OptOutKeywordType = "stop"
+	StartOptOutKeywordType OptOutKeywordType = "start"
+	HelpOptOutKeywordType  OptOutKeywordType = "help"
+)
+
+var DefaultStopKeywords = []string{"STOP", "STOPALL", "UNSUBSCRIBE", "CANCEL", "END", "QUIT", "OPTOUT", "REVOKE"}
+var DefaultStartKeywords = []string{"START", "UNSTOP", "YES", "SUBSCRIBE", "OPTIN"}
+var DefaultHelpKeywords = []string{"HELP", "INFO"}
+
+const DefaultStopReply = "You have been unsubscribed and will receive no further messages. Reply START to resubscribe."
+const DefaultStartReply = "You have been resubscribed. Reply STOP to unsubscribe."
+const DefaultHelpReply = "Reply STOP to unsubscribe or START to resubscribe."
+
+type OptedOutError struct {
+	Number string
+}
+
+func (e *OptedOutError) Error() string {
+	return fmt.Sprintf("recipient %s has opted out", e.Number)
+}
+
+type OptOutStore interface {
+	IsOptedOut(number string) (bool, error)
+	OptOut(number string) error
+	OptIn(number string) error
+}
+
+type MemoryOptOutStore struct {
+	mu      sync.RWMutex
+	numbers map[string]time.Time
+}
+
+func NewMemoryOptOutStore() *MemoryOptOutStore {
+	return &MemoryOptOutStore{numbers: map[string]time.Time{}}
+}
+
+func (s *MemoryOptOutStore) IsOptedOut(number string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.numbers[normalizeOptOutNumber(number)]
+	return ok, nil
+}
+
+func (s *MemoryOptOutStore) OptOut(number string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.numbers[normalizeOptOutNumber(number)] = time.Now().UTC()
+	return nil
+}
+
+func (s *MemoryOptOutStore) OptIn(number string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.numbers, normalizeOptOutNumber(number))
+	return nil
+}
+
+type FileOptOutStore struct {
+	mu      sync.RWMutex
+	path    string
+	numbers map[string]time.Time
+}
+
+func NewFileOptOutStore(path string) (*FileOptOutStore, error) {
+	store := &FileOptOutStore{path: path, numbers: map[string]time.Time{}}
+
+	data, err := os.ReadFile(path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &store.numbers); err != nil {
+			return nil, err
+		}
+	}
+
+	return store, nil
+}
+
+func (s *FileOptOutStore) IsOptedOut(number string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.numbers[normalizeOptOutNumber(number)]
+	return ok, nil
+}
+
+func (s *FileOptOutStore) OptOut(number string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.numbers[normalizeOptOutNumber(number)] = time.Now().UTC()
+	return s.save()
+}
+
+func (s *FileOptOutStore) OptIn(number string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.numbers, normalizeOptOutNumber(number))
+	return s.save()
+}
+
+func (s *FileOptOutStore) save() error {
+	data, err := json.MarshalIndent(s.numbers, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(s.path, data)
+}
+
+type OptOutManagerOptions struct {
+	Store         OptOutStore
+	StopKeywords  []string
+	StartKeywords []string
+	HelpKeywords  []string
+	StopReply     string
+	StartReply    string
+	HelpReply     string
+	DisableReply  bool
+}
+
+type OptOutSendResult struct {
+	Payload  SendMessagePayload
+	Response *MessageResponseBody
+	Error    error
+}
+
+type OptOutManager struct {
+	sms      SmsServiceInterface
+	options  OptOutManagerOptions
+	keywords map[string]OptOutKeywordType
+}
+
+func NewOptOutManager(sms SmsServiceInterface, options OptOutManagerOptions) *OptOutManager {
+	if options.Store == nil {
+		options.Store = NewMemoryOptOutStore()
+	}
+
+	if options.StopKeywords == nil {
+		options.StopKeywords = DefaultStopKeywords
+	}
+
+	if options.StartKeywords == nil {
+		options.StartKeywords = DefaultStartKeywords
+	}
+
+	if options.HelpKeywords == nil {
+		options.HelpKeywords = DefaultHelpKeywords
+	}
+
+	if options.StopReply == "" {
+		options.StopReply = DefaultStopReply
+	}
+
+	if options.StartReply == "" {
+		options.StartReply = DefaultStartReply
+	}
+
+	if options.HelpReply == "" {
+		options.HelpReply = DefaultHelpReply
+	}
+
+	keywords := map[string]OptOutKeywordType{}
+	for _, keyword := range options.HelpKeywords {
+		keywords[strings.ToUpper(keyword)] = HelpOptOutKeywordType
+	}
+	for _, keyword := range options.StartKeywords {
+		keywords[strings.ToUpper(keyword)] = StartOptOutKeywordType
+	}
+	for _, keyword := range options.StopKeywords {
+		keywords[strings.ToUpper(keyword)] = StopOptOutKeywordType
+	}
+
+	return &OptOutManager{sms: sms, options: options, keywords: keywords}
+}
+
+func (m *OptOutManager) Store() OptOutStore {
+	return m.options.Store
+}
+
+func (m *OptOutManager) MatchKeyword(text string) (OptOutKeywordType, bool) {
+	keyword := strings.ToUpper(strings.Trim(strings.TrimSpace(text), ".!?,;:\"'"))
+	keywordType, ok := m.keywords[keyword]
+	return keywordType, ok
+}
+
+func (m *OptOutManager) HandleInbound(message MessageResponseBody) (OptOutKeywordType, error) {
+	if message.Direction != "" && message.Direction != InboundMessageDirection {
+		return "", nil
+	}
+
+	keywordType, ok := m.MatchKeyword(message.MessageBody.Text)
+
+	if !ok {
+		return "", nil
+	}
+
+	var reply string
+
+	switch keywordType {
+	case StopOptOutKeywordType:
+		if err := m.options.Store.OptOut(message.From); err != nil {
+			return keywordType, err
+		}
+		reply = m.options.StopReply
+	case StartOptOutKeywordType:
+		if err := m.options.Store.OptIn(message.From); err != nil {
+			return keywordType, err
+		}
+		reply = m.options.StartReply
+	case HelpOptOutKeywordType:
+		reply = m.options.HelpReply
+	}
+
+	if m.options.DisableReply {
+		return keywordType, nil
+	}
+
+	_, httpError := m.sms.SendMessage(SendMessagePayload{
+		From:        message.To,
+		To:          message.From,
+		MessageBody: MessageBody{Text: reply},
+	})
+
+	if httpError != nil {
+		return keywordType, httpError
+	}
+
+	return keywordType, nil
+}
+
+func (m *OptOutManager) SendMessage(payload SendMessagePayload) (*MessageResponseBody, error) {
+	optedOut, err := m.options.Store.IsOptedOut(payload.To)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if optedOut {
+		return nil, &OptedOutError{Number: payload.To}
+	}
+
+	response, httpError := m.sms.SendMessage(payload)
+
+	if httpError != nil {
+		return nil, httpError
+	}
+
+	return response, nil
+}
+
+func (m *OptOutManager) SendMessages(payloads []SendMessagePayload) []OptOutSendResult {
+	results := make([]OptOutSendResult, len(payloads))
+
+	for index, payload := range payloads {
+		response, err := m.SendMessage(payload)
+		results[index] = OptOutSendResult{Payload: payload, Response: response, Error: err}
+	}
+
+	return results
+}
+
+func normalizeOptOutNumber(number string) string {
+	if digits := phoneDigits(number); digits != "" {
+		return digits
+	}
+
+	return strings.ToUpper(strings.TrimSpace(number))
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,432 @@
+package wavix
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+type QuietHours struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+type ScheduledMessage struct {
+	Id          string             `json:"id"`
+	Payload     SendMessagePayload `json:"payload"`
+	RequestedAt time.Time          `json:"requested_at"`
+	SendAt      time.Time          `json:"send_at"`
+	Timezone    string             `json:"timezone,omitempty"`
+	Attempts    int                `json:"attempts"`
+	LastError   string             `json:"last_error,omitempty"`
+}
+
+type ScheduledMessageStore interface {
+	Save(message ScheduledMessage) error
+	Delete(id string) error
+	List() ([]ScheduledMessage, error)
+}
+
+type MemoryScheduledMessageStore struct {
+	mu       sync.RWMutex
+	messages map[string]ScheduledMessage
+}
+
+func NewMemoryScheduledMessageStore() *MemoryScheduledMessageStore {
+	return &MemoryScheduledMessageStore{messages: map[string]ScheduledMessage{}}
+}
+
+func (s *MemoryScheduledMessageStore) Save(message ScheduledMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages[message.Id] = message
+	return nil
+}
+
+func (s *MemoryScheduledMessageStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.messages, id)
+	return nil
+}
+
+func (s *MemoryScheduledMessageStore) List() ([]ScheduledMessage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return sortScheduledMessages(s.messages), nil
+}
+
+type FileScheduledMessageStore struct {
+	mu       sync.RWMutex
+	path     string
+	messages map[string]ScheduledMessage
+}
+
+func NewFileScheduledMessageStore(path string) (*FileScheduledMessageStore, error) {
+	store := &FileScheduledMessageStore{path: path, messages: map[string]ScheduledMessage{}}
+
+	data, err := os.ReadFile(path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > 0 {
+		var messages []ScheduledMessage
+		if err := json.Unmarshal(data, &messages); err != nil {
+			return nil, err
+		}
+
+		for _, message := range messages {
+			store.messages[message.Id] = message
+		}
+	}
+
+	return store, nil
+}
+
+func (s *FileScheduledMessageStore) Save(message ScheduledMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages[message.Id] = message
+	return s.save()
+}
+
+func (s *FileScheduledMessageStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.messages, id)
+	return s.save()
+}
+
+func (s *FileScheduledMessageStore) List() ([]ScheduledMessage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return sortScheduledMessages(s.messages), nil
+}
+
+func (s *FileScheduledMessageStore) save() error {
+	data, err := json.MarshalIndent(sortScheduledMessages(s.messages), "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(s.path, data)
+}
+
+type SmsSchedulerOptions struct {
+	Store            ScheduledMessageStore
+	Validation       NumberValidationServiceInterface
+	ValidationType   ValidationType
+	QuietHours       *QuietHours
+	DefaultTimezone  *time.Location
+	PollInterval     time.Duration
+	MaxAttempts      int
+	RetryDelay       time.Duration
+	OnSent           func(message ScheduledMessage, response *MessageResponseBody)
+	OnFailed         func(message ScheduledMessage, err error)
+	OnError          func(err error)
+	TimezoneResolver func(number string) (*time.Location, error)
+}
+
+type SmsScheduler struct {
+	sms       SmsServiceInterface
+	options   SmsSchedulerOptions
+	mu        sync.Mutex
+	queueMu   sync.Mutex
+	timezones map[string]*time.Location
+}
+
+func NewSmsScheduler(sms SmsServiceInterface, options SmsSchedulerOptions) *SmsScheduler {
+	if options.Store == nil {
+		options.Store = NewMemoryScheduledMessageStore()
+	}
+
+	if options.ValidationType == "" {
+		options.ValidationType = FormatValidationType
+	}
+
+	if options.DefaultTimezone == nil {
+		options.DefaultTimezone = time.UTC
+	}
+
+	if options.PollInterval <= 0 {
+		options.PollInterval = 30 * time.Second
+	}
+
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = 3
+	}
+
+	if options.RetryDelay <= 0 {
+		options.RetryDelay = time.Minute
+	}
+
+	return &SmsScheduler{sms: sms, options: options, timezones: map[string]*time.Location{}}
+}
+
+func (s *SmsScheduler) Schedule(payload SendMessagePayload, sendAt time.Time) (*ScheduledMessage, error) {
+	if httpError := normalizeSendMessagePayload(&payload); httpError != nil {
+		return nil, httpError
+	}
+
+	if httpError := ValidateSendMessagePayload(payload); httpError != nil {
+		return nil, httpError
+	}
+
+	location := s.resolveTimezone(payload.To)
+	message := ScheduledMessage{
+		Id:          newScheduledMessageId(),
+		Payload:     payload,
+		RequestedAt: sendAt,
+		SendAt:      s.applyQuietHours(sendAt, location),
+		Timezone:    location.String(),
+	}
+
+	if err := s.options.Store.Save(message); err != nil {
+		return nil, err
+	}
+
+	return &message, nil
+}
+
+func (s *SmsScheduler) ScheduleAtLocalTime(payload SendMessagePayload, date time.Time, hour int, minute int) (*ScheduledMessage, error) {
+	to, err := normalizePhoneNumber(payload.To)
+
+	if err != nil {
+		return nil, phoneFieldsError(map[string]string{"to": err.Error()})
+	}
+
+	location := s.resolveTimezone(to)
+	sendAt := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, location)
+
+	return s.Schedule(payload, sendAt)
+}
+
+func (s *SmsScheduler) Cancel(externalId string) (int, error) {
+	s.queueMu.Lock()
+	defer s.queueMu.Unlock()
+
+	messages, err := s.options.Store.List()
+
+	if err != nil {
+		return 0, err
+	}
+
+	cancelled := 0
+
+	for _, message := range messages {
+		if message.Payload.ExternalId == nil || *message.Payload.ExternalId != externalId {
+			continue
+		}
+
+		if err := s.options.Store.Delete(message.Id); err != nil {
+			return cancelled, err
+		}
+
+		cancelled++
+	}
+
+	return cancelled, nil
+}
+
+func (s *SmsScheduler) Pending() ([]ScheduledMessage, error) {
+	return s.options.Store.List()
+}
+
+func (s *SmsScheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.options.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.ProcessDue(time.Now()); err != nil && s.options.OnError != nil {
+			s.options.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *SmsScheduler) ProcessDue(now time.Time) error {
+	s.queueMu.Lock()
+	defer s.queueMu.Unlock()
+
+	messages, err := s.options.Store.List()
+
+	if err != nil {
+		return err
+	}
+
+	for _, message := range messages {
+		if message.SendAt.After(now) {
+			continue
+		}
+
+		location, err := utils.ParseTimezone(message.Timezone)
+		if err != nil {
+			location = s.options.DefaultTimezone
+		}
+
+		if adjusted := s.applyQuietHours(now, location); adjusted.After(now) {
+			message.SendAt = adjusted
+			if err := s.options.Store.Save(message); err != nil {
+				return err
+			}
+			continue
+		}
+
+		response, httpError := s.sms.SendMessage(message.Payload)
+
+		if httpError == nil {
+			if err := s.options.Store.Delete(message.Id); err != nil {
+				return err
+			}
+
+			if s.options.OnSent != nil {
+				s.options.OnSent(message, response)
+			}
+			continue
+		}
+
+		message.Attempts++
+		message.LastError = httpError.Message
+
+		if message.Attempts >= s.options.MaxAttempts {
+			if err := s.options.Store.Delete(message.Id); err != nil {
+				return err
+			}
+
+			if s.options.OnFailed != nil {
+				s.options.OnFailed(message, httpError)
+			}
+			continue
+		}
+
+		message.SendAt = now.Add(s.options.RetryDelay)
+		if err := s.options.Store.Save(message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *SmsScheduler) resolveTimezone(number string) *time.Location {
+	s.mu.Lock()
+	location, ok := s.timezones[number]
+	s.mu.Unlock()
+
+	if ok {
+		return location
+	}
+
+	location = s.options.DefaultTimezone
+
+	if s.options.TimezoneResolver != nil {
+		if resolved, err := s.options.TimezoneResolver(number); err == nil && resolved != nil {
+			location = resolved
+		}
+	} else if s.options.Validation != nil {
+		result, httpError := s.options.Validation.ValidateSingle(number, s.options.ValidationType)
+		if httpError == nil && result.Location != nil {
+			location = result.Location
+		}
+	}
+
+	s.mu.Lock()
+	s.timezones[number] = location
+	s.mu.Unlock()
+
+	return location
+}
+
+func (s *SmsScheduler) applyQuietHours(sendAt time.Time, location *time.Location) time.Time {
+	if s.options.QuietHours == nil {
+		return sendAt
+	}
+
+	return s.options.QuietHours.NextAllowed(sendAt, location)
+}
+
+func (q QuietHours) Contains(t time.Time, location *time.Location) bool {
+	local := t.In(location)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location)
+	offset := local.Sub(midnight)
+
+	if q.Start == q.End {
+		return false
+	}
+
+	if q.Start < q.End {
+		return offset >= q.Start && offset < q.End
+	}
+
+	return offset >= q.Start || offset < q.End
+}
+
+func (q QuietHours) NextAllowed(t time.Time, location *time.Location) time.Time {
+	if !q.Contains(t, location) {
+		return t
+	}
+
+	local := t.In(location)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location)
+	end := midnight.Add(q.End)
+
+	if !end.After(local) {
+		end = time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, location).Add(q.End)
+	}
+
+	return end
+}
+
+func sortScheduledMessages(messages map[string]ScheduledMessage) []ScheduledMessage {
+	result := make([]ScheduledMessage, 0, len(messages))
+
+	for _, message := range messages {
+		result = append(result, message)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].SendAt.Equal(result[j].SendAt) {
+			return result[i].Id < result[j].Id
+		}
+		return result[i].SendAt.Before(result[j].SendAt)
+	})
+
+	return result
+}
+
+func newScheduledMessageId() string {
+	buffer := make([]byte, 16)
+
+	if _, err := rand.Read(buffer); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+
+	return hex.EncodeToString(buffer)
+}
@@ -0,0 +1,33 @@
+package wavix
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/wavix/sdk-go/utils"
+)
+
+func TestGetMessageEscapesId(t *testing.T) {
+	requested := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.EscapedPath()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	service := &SmsService{utils.InitHttpConfig(server.URL, "test")}
+
+	if _, err := service.GetMessage("../v1/profile"); err != nil {
+		t.Fatal(err)
+	}
+
+	if requested != "/v2/messages/..%2Fv1%2Fprofile" {
+		t.Fatalf("requested %q", requested)
+	}
+
+	if _, err := service.GetMessage(".."); err == nil {
+		t.Fatal("expected an error for a dot-dot id")
+	}
+}
@@ -43,15 +43,15 @@ type TranscriptionTurn struct {
 }
 
 type SpeechAnalyticsCallItem struct {
-	Charge        string                            `json:"charge"`
-	Date          string                            `json:"date"`
+	Charge        utils.Money                       `json:"charge"`
+	Date          utils.Timestamp                   `json:"date"`
 	Destination   string                            `json:"destination"`
 	Disposition   string                            `json:"disposition"`
 	Duration      int                               `json:"duration"`
-	ForwardFee    string                            `json:"forward_fee"`
+	ForwardFee    utils.Money                       `json:"forward_fee"`
 	From          string                            `json:"from"`
 	To            string                            `json:"to"`
-	PerMinute     string                            `json:"per_minute"`
+	PerMinute     utils.Money                       `json:"per_minute"`
 	Uuid          string                            `json:"uuid"`
 	SipTrunk      string                            `json:"sip_trunk"`
 	Transcription *SpeechAnalyticsCallTranscription `json:"transcription"`
@@ -90,9 +90,9 @@ type RequestTranscriptionByCallIdResponse struct {
 	Uuid              string                  `json:"uuid"`
 	Language          SpeechAnalyticsLanguage `json:"language"`
 	Duration          int                     `json:"duration"`
-	Charge            string                  `json:"charge"`
+	Charge            utils.Money             `json:"charge"`
 	Status            string                  `json:"status"`
-	TranscriptionDate string                  `json:"transcription_date"`
+	TranscriptionDate utils.Timestamp         `json:"transcription_date"`
 }
 
 func (s *SpeechAnalyticsService) GetSpeechAnalyticsCalls(payload GetSpeechAnalyticsCallsPayload) (*utils.PaginationResponse[SpeechAnalyticsCallItem], *utils.HttpErrorResponse) {
@@ -103,6 +103,8 @@ func (s *SpeechAnalyticsService) GetSpeechAnalyticsCalls(payload GetSpeechAnalyt
 		return nil, &utils.HttpErrorResponse{Message: err.Error()}
 	}
 
+	payload.RequiredDatePayload = payload.RequiredDatePayload.WithDefaultLocation(s.httpConfig.LocationResolver)
+
 	return utils.Post[utils.PaginationResponse[SpeechAnalyticsCallItem]](*s.httpConfig, "/v1/cdr", payload, utils.PaginationResponse[SpeechAnalyticsCallItem]{})
 }
 
@@ -27,14 +27,14 @@ const (
 )
 
 type TwoFaVerificationListItem struct {
-	CreatedAt          string `json:"created_at"`
-	SessionId          string `json:"session_id"`
-	PhoneNumber        string `json:"phone_number"`
-	DestinationCountry string `json:"destination_country"`
-	Status             string `json:"status"`
-	Charge             string `json:"charge"`
-	ServiceId          string `json:"service_id"`
-	ServiceName        string `json:"service_name"`
+	CreatedAt          utils.Timestamp `json:"created_at"`
+	SessionId          string          `json:"session_id"`
+	PhoneNumber        string          `json:"phone_number"`
+	DestinationCountry string          `json:"destination_country"`
+	Status             string          `json:"status"`
+	Charge             utils.Money     `json:"charge"`
+	ServiceId          string          `json:"service_id"`
+	ServiceName        string          `json:"service_name"`
 }
 
 type TwoFaLookup struct {
@@ -44,11 +44,11 @@ type TwoFaLookup struct {
 }
 
 type TwoFaVerificationEventListItem struct {
-	CreatedAt string `json:"created_at"`
-	Event     string `json:"event"`
-	Status    string `json:"status"`
-	Charge    string `json:"charge"`
-	Error     string `json:"error"`
+	CreatedAt utils.Timestamp `json:"created_at"`
+	Event     string          `json:"event"`
+	Status    string          `json:"status"`
+	Charge    utils.Money     `json:"charge"`
+	Error     string          `json:"error"`
 }
 
 type GetServiceVerificationsQueryParams struct {
@@ -70,14 +70,14 @@ type ValidateTwoFaCodePayload struct {
 }
 
 type CreateTwoFaVerificationResponse struct {
-	Success     bool        `json:"success"`
-	ServiceId   string      `json:"service_id"`
-	SessionUrl  string      `json:"session_url"`
-	SessionId   string      `json:"session_id"`
-	Destination string      `json:"destination"`
-	CreatedAt   string      `json:"created_at"`
-	Lookup      TwoFaLookup `json:"lookup"`
-	Charge      string      `json:"charge"`
+	Success     bool            `json:"success"`
+	ServiceId   string          `json:"service_id"`
+	SessionUrl  string          `json:"session_url"`
+	SessionId   string          `json:"session_id"`
+	Destination string          `json:"destination"`
+	CreatedAt   utils.Timestamp `json:"created_at"`
+	Lookup      TwoFaLookup     `json:"lookup"`
+	Charge      utils.Money     `json:"charge"`
 }
 
 type ValidateTwoFaCodeResponse struct {
@@ -88,11 +88,12 @@ type ResendTwoFaVerificationCodeResponse struct {
 	Success     bool             `json:"success"`
 	Channel     TwoFaChannelType `json:"channel"`
 	Destination string           `json:"destination"`
-	CreatedAt   string           `json:"created_at"`
+	CreatedAt   utils.Timestamp  `json:"created_at"`
 }
 
 func (s *TwoFaService) GetServiceVerifications(serviceId string, queryParams GetServiceVerificationsQueryParams) (*[]TwoFaVerificationListItem, *utils.HttpErrorResponse) {
 	basePath := path.Join("/v1/two-fa/service", serviceId, "sessions")
+	queryParams.RequiredDateParams = queryParams.RequiredDateParams.WithDefaultLocation(s.httpConfig.LocationResolver)
 	url := utils.BuildUrlWithQueryString(basePath, queryParams)
 
 	return utils.Get[[]TwoFaVerificationListItem](*s.httpConfig, url, []TwoFaVerificationListItem{})
@@ -105,6 +106,10 @@ func (s *TwoFaService) GetServiceVerificationEvents(sessionId string) (*[]TwoFaV
 }
 
 func (s *TwoFaService) CreateVerification(payload CreateTwoFaVerificationPayload) (*CreateTwoFaVerificationResponse, *utils.HttpErrorResponse) {
+	if httpError := normalizePhoneFields(map[string]*string{"to": &payload.To}); httpError != nil {
+		return nil, httpError
+	}
+
 	validate := utils.GetValidate()
 	err := validate.Struct(payload)
 
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"time"
 )
@@ -9,7 +10,26 @@ import (
 type PayloadDateParams time.Time
 type QueryDateParams time.Time
 
+type DateValue interface {
+	DateParam() DateParam
+	EncodeValues(key string, v *url.Values) error
+}
+
+type DatePrecision int
+
+const (
+	DayDatePrecision DatePrecision = iota
+	SecondDatePrecision
+)
+
+type DateParam struct {
+	Time      time.Time
+	Precision DatePrecision
+	Location  *time.Location
+}
+
 const timeFormat = "2006-01-02"
+const dateTimeFormat = time.RFC3339
 
 func (qdp QueryDateParams) String() string {
 	return time.Time(qdp).Format(timeFormat)
@@ -20,6 +40,14 @@ func (qdp QueryDateParams) EncodeValues(key string, v *url.Values) error {
 	return nil
 }
 
+func (qdp QueryDateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(qdp.String())
+}
+
+func (qdp QueryDateParams) DateParam() DateParam {
+	return Date(time.Time(qdp))
+}
+
 func (dp PayloadDateParams) MarshalJSON() ([]byte, error) {
 	return json.Marshal(time.Time(dp).Format(timeFormat))
 }
@@ -34,22 +62,221 @@ func (dp *PayloadDateParams) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (dp PayloadDateParams) EncodeValues(key string, v *url.Values) error {
+	v.Add(key, time.Time(dp).Format(timeFormat))
+	return nil
+}
+
+func (dp PayloadDateParams) DateParam() DateParam {
+	return Date(time.Time(dp))
+}
+
+func Date(t time.Time) DateParam {
+	return DateParam{Time: t, Precision: DayDatePrecision, Location: t.Location()}
+}
+
+func DateTime(t time.Time) DateParam {
+	return DateParam{Time: t, Precision: SecondDatePrecision}
+}
+
+func DateTimeIn(t time.Time, location *time.Location) DateParam {
+	return DateParam{Time: t, Precision: SecondDatePrecision, Location: location}
+}
+
+func (dp DateParam) DateParam() DateParam {
+	return dp
+}
+
+func (dp DateParam) In(location *time.Location) DateParam {
+	if dp.Precision == DayDatePrecision && location != nil {
+		year, month, day := dp.Time.Date()
+		dp.Time = time.Date(year, month, day, 0, 0, 0, 0, location)
+	}
+
+	dp.Location = location
+	return dp
+}
+
+func (dp DateParam) WithDefaultLocation(resolve func() *time.Location) DateParam {
+	if dp.Precision == DayDatePrecision || dp.Location != nil || dp.Time.IsZero() || resolve == nil {
+		return dp
+	}
+
+	dp.Location = resolve()
+	return dp
+}
+
+func (dp DateParam) IsZero() bool {
+	return dp.Time.IsZero()
+}
+
+func (dp DateParam) String() string {
+	if dp.Precision == DayDatePrecision {
+		return dp.Time.Format(timeFormat)
+	}
+
+	t := dp.Time
+
+	if dp.Location != nil {
+		t = t.In(dp.Location)
+	}
+
+	return t.Format(dateTimeFormat)
+}
+
+func (dp DateParam) EncodeValues(key string, v *url.Values) error {
+	if dp.IsZero() {
+		return nil
+	}
+
+	v.Add(key, dp.String())
+	return nil
+}
+
+func (dp DateParam) MarshalJSON() ([]byte, error) {
+	if dp.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(dp.String())
+}
+
+func (dp *DateParam) UnmarshalJSON(data []byte) error {
+	var str string
+
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	if t, err := time.Parse(timeFormat, str); err == nil {
+		*dp = Date(t)
+		return nil
+	}
+
+	t, err := time.Parse(dateTimeFormat, str)
+
+	if err != nil {
+		return fmt.Errorf("invalid date %q", str)
+	}
+
+	*dp = DateTimeIn(t, t.Location())
+	return nil
+}
+
 type RequiredDateParams struct {
-	From QueryDateParams `validate:"required" url:"from,omitempty"`
-	To   QueryDateParams `validate:"required" url:"to,omitempty"`
+	From DateValue `validate:"required" url:"from,omitempty"`
+	To   DateValue `validate:"required" url:"to,omitempty"`
 }
 
 type RequiredDatePayload struct {
-	From PayloadDateParams `validate:"required" json:"from,omitempty"`
-	To   PayloadDateParams `validate:"required" json:"to,omitempty"`
+	From DateValue `validate:"required" json:"from,omitempty"`
+	To   DateValue `validate:"required" json:"to,omitempty"`
 }
 
 type OptionalDateParams struct {
-	From QueryDateParams `validate:"omitempty" url:"from,omitempty"`
-	To   QueryDateParams `validate:"omitempty" url:"to,omitempty"`
+	From DateValue `validate:"omitempty" url:"from,omitempty"`
+	To   DateValue `validate:"omitempty" url:"to,omitempty"`
 }
 
 type OptionalDatePayload struct {
-	From PayloadDateParams `validate:"omitempty" json:"from,omitempty"`
-	To   PayloadDateParams `validate:"omitempty" json:"to,omitempty"`
+	From DateValue `validate:"omitempty" json:"from,omitempty"`
+	To   DateValue `validate:"omitempty" json:"to,omitempty"`
+}
+
+func (p RequiredDateParams) WithDefaultLocation(resolve func() *time.Location) RequiredDateParams {
+	return RequiredDateParams{From: withDefaultLocation(p.From, resolve), To: withDefaultLocation(p.To, resolve)}
+}
+
+func (p RequiredDatePayload) WithDefaultLocation(resolve func() *time.Location) RequiredDatePayload {
+	return RequiredDatePayload{From: withDefaultLocation(p.From, resolve), To: withDefaultLocation(p.To, resolve)}
+}
+
+func (p OptionalDateParams) WithDefaultLocation(resolve func() *time.Location) OptionalDateParams {
+	return OptionalDateParams{From: withDefaultLocation(p.From, resolve), To: withDefaultLocation(p.To, resolve)}
+}
+
+func (p OptionalDatePayload) WithDefaultLocation(resolve func() *time.Location) OptionalDatePayload {
+	return OptionalDatePayload{From: withDefaultLocation(p.From, resolve), To: withDefaultLocation(p.To, resolve)}
+}
+
+func withDefaultLocation(value DateValue, resolve func() *time.Location) DateValue {
+	param, ok := value.(DateParam)
+
+	if !ok {
+		return value
+	}
+
+	return param.WithDefaultLocation(resolve)
+}
+
+type DateRange struct {
+	From DateParam
+	To   DateParam
+}
+
+func Between(from time.Time, to time.Time) DateRange {
+	return DateRange{From: DateTime(from), To: DateTime(to)}
+}
+
+func Days(from time.Time, to time.Time) DateRange {
+	return DateRange{From: Date(from), To: Date(to)}
+}
+
+func Last(duration time.Duration) DateRange {
+	now := time.Now()
+	return Between(now.Add(-duration), now)
+}
+
+func Today(location *time.Location) DateRange {
+	now := time.Now()
+
+	if location != nil {
+		now = now.In(location)
+	}
+
+	return DateRange{
+		From: DateParam{Time: now, Precision: DayDatePrecision, Location: location},
+		To:   DateParam{Time: now, Precision: DayDatePrecision, Location: location},
+	}
+}
+
+func Yesterday(location *time.Location) DateRange {
+	yesterday := time.Now().Add(-24 * time.Hour)
+
+	if location != nil {
+		now := time.Now().In(location)
+		yesterday = time.Date(now.Year(), now.Month(), now.Day()-1, 12, 0, 0, 0, location)
+	}
+
+	return DateRange{
+		From: DateParam{Time: yesterday, Precision: DayDatePrecision, Location: location},
+		To:   DateParam{Time: yesterday, Precision: DayDatePrecision, Location: location},
+	}
+}
+
+func Month(year int, month time.Month) DateRange {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1)
+
+	return Days(first, last)
+}
+
+func (r DateRange) In(location *time.Location) DateRange {
+	return DateRange{From: r.From.In(location), To: r.To.In(location)}
+}
+
+func (r DateRange) Required() RequiredDateParams {
+	return RequiredDateParams{From: r.From, To: r.To}
+}
+
+func (r DateRange) Optional() OptionalDateParams {
+	return OptionalDateParams{From: r.From, To: r.To}
+}
+
+func (r DateRange) RequiredPayload() RequiredDatePayload {
+	return RequiredDatePayload{From: r.From, To: r.To}
+}
+
+func (r DateRange) OptionalPayload() OptionalDatePayload {
+	return OptionalDatePayload{From: r.From, To: r.To}
 }
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestDayPrecisionKeepsCalendarDay(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("timezone data unavailable")
+	}
+
+	resolve := func() *time.Location { return newYork }
+	september := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value DateValue
+		want  string
+	}{
+		{"date", Date(september), "2026-09-01"},
+		{"date literal with default location", DateParam{Time: september}.WithDefaultLocation(resolve), "2026-09-01"},
+		{"date in location", Date(september).In(newYork), "2026-09-01"},
+		{"legacy query type", QueryDateParams(september), "2026-09-01"},
+		{"legacy payload type", PayloadDateParams(september), "2026-09-01"},
+		{"date time with default location", DateTime(september).WithDefaultLocation(resolve), "2026-08-31T20:00:00-04:00"},
+	}
+
+	for _, test := range tests {
+		values := url.Values{}
+
+		if err := test.value.EncodeValues("from", &values); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+
+		if got := values.Get("from"); got != test.want {
+			t.Errorf("%s: got %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestLegacyDateParamsEncode(t *testing.T) {
+	params := RequiredDateParams{
+		From: QueryDateParams(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)),
+		To:   Date(time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC)),
+	}
+
+	if got := BuildUrlWithQueryString("/v1/cdr", params); got != "/v1/cdr?from=2026-09-01&to=2026-09-30" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestLegacyDateParamsMarshal(t *testing.T) {
+	payload := RequiredDatePayload{
+		From: QueryDateParams(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)),
+		To:   PayloadDateParams(time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC)),
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `{"from":"2026-09-01","to":"2026-09-30"}` {
+		t.Errorf("got %s", data)
+	}
+}
@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type DownloadInfo struct {
+	FileName      string
+	ContentType   string
+	ContentLength int64
+	Offset        int64
+	Written       int64
+}
+
+func (d DownloadInfo) Complete() bool {
+	return d.ContentLength < 0 || d.Offset+d.Written >= d.ContentLength
+}
+
+var downloadClient = &http.Client{Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, ResponseHeaderTimeout: time.Second * 30}}
+
+func DownloadTo(ctx context.Context, config HttpConfig, path string, w io.Writer, offset int64) (*DownloadInfo, *HttpErrorResponse) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, getUrl(config, path), nil)
+
+	if err != nil {
+		return nil, &HttpErrorResponse{Success: false, Message: err.Error()}
+	}
+
+	if offset > 0 {
+		request.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	response, err := downloadClient.Do(request)
+
+	if err != nil {
+		return nil, &HttpErrorResponse{Success: false, Message: "No file was downloaded"}
+	}
+
+	defer response.Body.Close()
+
+	info := &DownloadInfo{
+		FileName:      downloadFileName(response.Header.Get("Content-Disposition")),
+		ContentType:   response.Header.Get("Content-Type"),
+		ContentLength: -1,
+		Offset:        offset,
+	}
+
+	switch {
+	case response.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0:
+		total := downloadTotalSize(response.Header.Get("Content-Range"), offset, -1)
+
+		if total != offset {
+			return nil, &HttpErrorResponse{Success: false, Message: fmt.Sprintf("Cannot resume download at byte %d of %d", offset, total)}
+		}
+
+		info.ContentLength = total
+		return info, nil
+	case response.StatusCode == http.StatusPartialContent:
+		info.ContentLength = downloadTotalSize(response.Header.Get("Content-Range"), offset, response.ContentLength)
+	case response.StatusCode == http.StatusOK:
+		info.Offset = 0
+		info.ContentLength = response.ContentLength
+	default:
+		return nil, downloadError(response)
+	}
+
+	if strings.HasPrefix(info.ContentType, "application/json") {
+		return nil, downloadError(response)
+	}
+
+	if response.StatusCode == http.StatusOK && offset > 0 {
+		if _, err := io.CopyN(io.Discard, response.Body, offset); err != nil {
+			return nil, &HttpErrorResponse{Success: false, Message: "Failed to resume download"}
+		}
+
+		info.Offset = offset
+	}
+
+	written, err := io.Copy(w, response.Body)
+	info.Written = written
+
+	if err != nil {
+		return info, &HttpErrorResponse{Success: false, Message: err.Error()}
+	}
+
+	return info, nil
+}
+
+func downloadFileName(contentDisposition string) string {
+	if contentDisposition == "" {
+		return ""
+	}
+
+	_, params, err := mime.ParseMediaType(contentDisposition)
+
+	if err != nil {
+		return ""
+	}
+
+	return params["filename"]
+}
+
+func downloadTotalSize(contentRange string, offset int64, contentLength int64) int64 {
+	if index := strings.LastIndex(contentRange, "/"); index >= 0 {
+		if total, err := strconv.ParseInt(contentRange[index+1:], 10, 64); err == nil {
+			return total
+		}
+	}
+
+	if contentLength < 0 {
+		return -1
+	}
+
+	return offset + contentLength
+}
+
+func downloadError(response *http.Response) *HttpErrorResponse {
+	body, _ := io.ReadAll(io.LimitReader(response.Body, 64*1024))
+
+	var object map[string]interface{}
+
+	if json.Unmarshal(body, &object) == nil {
+		if _, ok := object["message"].(string); ok {
+			return getErrorDetails(object)
+		}
+	}
+
+	return &HttpErrorResponse{Success: false, Message: fmt.Sprintf("No file was downloaded: %s", response.Status)}
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDownloadToChecksRangeTotalOnResume(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Range", "bytes */10")
+		w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+	}))
+	defer server.Close()
+
+	config := HttpConfig{BaseUrl: server.URL, AppId: "test"}
+
+	info, err := DownloadTo(context.Background(), config, "/file", &bytes.Buffer{}, 10)
+	if err != nil || !info.Complete() {
+		t.Fatalf("complete part file: info %+v, err %v", info, err)
+	}
+
+	if _, err := DownloadTo(context.Background(), config, "/file", &bytes.Buffer{}, 12); err == nil {
+		t.Fatal("expected an error when the part file is larger than the remote file")
+	}
+}
@@ -33,6 +33,10 @@ type HttpErrorResponse struct {
 	Errors  *map[string]string `json:"errors,omitempty"`
 }
 
+func (e *HttpErrorResponse) Error() string {
+	return e.Message
+}
+
 type SyncHangupResponse struct {
 	Success bool    `json:"success"`
 	Code    int     `json:"code"`
@@ -40,14 +44,23 @@ type SyncHangupResponse struct {
 }
 
 type HttpConfig struct {
-	BaseUrl string
-	AppId   string
+	BaseUrl          string
+	AppId            string
+	LocationResolver func() *time.Location
 }
 
 func InitHttpConfig(baseUrl string, appId string) *HttpConfig {
 	return &HttpConfig{BaseUrl: baseUrl, AppId: appId}
 }
 
+func (c HttpConfig) DefaultLocation() *time.Location {
+	if c.LocationResolver == nil {
+		return nil
+	}
+
+	return c.LocationResolver()
+}
+
 func Get[T any](config HttpConfig, path string, resultType T) (*T, *HttpErrorResponse) {
 	url := getUrl(config, path)
 	request, _ := http.NewRequest(http.MethodGet, url, nil)
@@ -93,9 +106,25 @@ func Upload(config HttpConfig, path string, data FileData) (*HttpSuccessBasicRes
 	return uploadFile(request, data)
 }
 
+func UploadWithResult[T any](config HttpConfig, path string, data FileData, resultType T) (*T, *HttpErrorResponse) {
+	url := getUrl(config, path)
+	body, contentType, errorResponse := buildMultipartBody(data)
+
+	if errorResponse != nil {
+		return nil, errorResponse
+	}
+
+	request, _ := http.NewRequest(http.MethodPost, url, body)
+	request.Header.Set("Content-Type", contentType)
+	return HttpRequest[T](request, url, resultType)
+}
+
 func HttpRequest[T any](request *http.Request, url string, successResponse T) (*T, *HttpErrorResponse) {
 	var errorResponse = HttpErrorResponse{Success: false, Message: "Internal server error"}
-	request.Header.Set("Content-Type", "application/json; charset=UTF-8")
+
+	if request.Header.Get("Content-Type") == "" {
+		request.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	}
 
 	client := &http.Client{Timeout: time.Second * 10}
 	response, err := client.Do(request)
@@ -167,7 +196,7 @@ func downloadFile(request *http.Request) ([]byte, *HttpErrorResponse) {
 	return fileData, nil
 }
 
-func uploadFile(request *http.Request, data FileData) (*HttpSuccessBasicResponse, *HttpErrorResponse) {
+func buildMultipartBody(data FileData) (*bytes.Buffer, string, *HttpErrorResponse) {
 	requestBodyBuffer := &bytes.Buffer{}
 	writer := multipart.NewWriter(requestBodyBuffer)
 
@@ -177,13 +206,13 @@ func uploadFile(request *http.Request, data FileData) (*HttpSuccessBasicResponse
 	fileWriter, err := writer.CreateFormFile(fileData.FileKey, fileData.FileName)
 
 	if err != nil {
-		return nil, &HttpErrorResponse{Success: false, Message: "Failed to create form file"}
+		return nil, "", &HttpErrorResponse{Success: false, Message: "Failed to create form file"}
 	}
 
 	_, err = io.Copy(fileWriter, fileData.Reader)
 
 	if err != nil {
-		return nil, &HttpErrorResponse{Success: false, Message: "Failed to copy file data"}
+		return nil, "", &HttpErrorResponse{Success: false, Message: "Failed to copy file data"}
 	}
 
 	for key, values := range formData {
@@ -195,13 +224,21 @@ func uploadFile(request *http.Request, data FileData) (*HttpSuccessBasicResponse
 	err = writer.Close()
 
 	if err != nil {
-		return nil, &HttpErrorResponse{Success: false, Message: "Failed to close writer"}
+		return nil, "", &HttpErrorResponse{Success: false, Message: "Failed to close writer"}
 	}
 
-	bodyBytes, _ := io.ReadAll(requestBodyBuffer)
+	return requestBodyBuffer, writer.FormDataContentType(), nil
+}
+
+func uploadFile(request *http.Request, data FileData) (*HttpSuccessBasicResponse, *HttpErrorResponse) {
+	body, contentType, errorResponse := buildMultipartBody(data)
+
+	if errorResponse != nil {
+		return nil, errorResponse
+	}
 
-	request.Header.Set("Content-Type", writer.FormDataContentType())
-	request.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+	request.Header.Set("Content-Type", contentType)
+	request.Body = io.NopCloser(body)
 
 	client := &http.Client{Timeout: time.Second * 10}
 	response, err := client.Do(request)
@@ -229,7 +266,6 @@ func uploadFile(request *http.Request, data FileData) (*HttpSuccessBasicResponse
 
 	defer response.Body.Close()
 	defer request.Body.Close()
-	defer writer.Close()
 
 	return &HttpSuccessBasicResponse{Success: true}, nil
 }
@@ -0,0 +1,280 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+type Money struct {
+	units *big.Int
+	scale int32
+}
+
+func NewMoney(units int64, scale int32) Money {
+	if scale < 0 {
+		return Money{units: new(big.Int).Mul(big.NewInt(units), pow10(-scale))}
+	}
+
+	return Money{units: big.NewInt(units), scale: scale}
+}
+
+const maxMoneyExponent = 64
+
+func ParseMoney(value string) (Money, error) {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		return Money{}, nil
+	}
+
+	mantissa := value
+	exponent := 0
+
+	if index := strings.IndexAny(value, "eE"); index >= 0 {
+		parsed, err := strconv.Atoi(value[index+1:])
+		if err != nil {
+			return Money{}, fmt.Errorf("invalid money value %q", value)
+		}
+
+		if parsed > maxMoneyExponent || parsed < -maxMoneyExponent {
+			return Money{}, fmt.Errorf("money value %q exponent is out of range", value)
+		}
+		mantissa = value[:index]
+		exponent = parsed
+	}
+
+	negative := false
+	if strings.HasPrefix(mantissa, "-") || strings.HasPrefix(mantissa, "+") {
+		negative = mantissa[0] == '-'
+		mantissa = mantissa[1:]
+	}
+
+	integer, fraction, _ := strings.Cut(mantissa, ".")
+
+	if integer == "" && fraction == "" {
+		return Money{}, fmt.Errorf("invalid money value %q", value)
+	}
+
+	digits := integer + fraction
+	for _, char := range digits {
+		if char < '0' || char > '9' {
+			return Money{}, fmt.Errorf("invalid money value %q", value)
+		}
+	}
+
+	units, _ := new(big.Int).SetString("0"+digits, 10)
+	scale := len(fraction) - exponent
+
+	if scale < 0 {
+		units.Mul(units, pow10(int32(-scale)))
+		scale = 0
+	}
+
+	if negative {
+		units.Neg(units)
+	}
+
+	return Money{units: units, scale: int32(scale)}, nil
+}
+
+func MustParseMoney(value string) Money {
+	money, err := ParseMoney(value)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return money
+}
+
+func SumMoney(values ...Money) Money {
+	total := Money{}
+
+	for _, value := range values {
+		total = total.Add(value)
+	}
+
+	return total
+}
+
+func (m Money) Scale() int32 {
+	return m.scale
+}
+
+func (m Money) Add(other Money) Money {
+	a, b, scale := align(m, other)
+	return Money{units: a.Add(a, b), scale: scale}
+}
+
+func (m Money) Sub(other Money) Money {
+	a, b, scale := align(m, other)
+	return Money{units: a.Sub(a, b), scale: scale}
+}
+
+func (m Money) MulInt(factor int64) Money {
+	return Money{units: new(big.Int).Mul(m.bigUnits(), big.NewInt(factor)), scale: m.scale}
+}
+
+func (m Money) Neg() Money {
+	return Money{units: new(big.Int).Neg(m.bigUnits()), scale: m.scale}
+}
+
+func (m Money) Abs() Money {
+	return Money{units: new(big.Int).Abs(m.bigUnits()), scale: m.scale}
+}
+
+func (m Money) Round(scale int32) Money {
+	if scale >= m.scale {
+		a, _, _ := align(m, Money{scale: scale})
+		return Money{units: a, scale: scale}
+	}
+
+	divisor := pow10(m.scale - scale)
+	quotient, remainder := new(big.Int).QuoRem(m.bigUnits(), divisor, new(big.Int))
+
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(divisor) >= 0 {
+		if m.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+
+	return Money{units: quotient, scale: scale}
+}
+
+func (m Money) Cmp(other Money) int {
+	a, b, _ := align(m, other)
+	return a.Cmp(b)
+}
+
+func (m Money) Equal(other Money) bool {
+	return m.Cmp(other) == 0
+}
+
+func (m Money) LessThan(other Money) bool {
+	return m.Cmp(other) < 0
+}
+
+func (m Money) GreaterThan(other Money) bool {
+	return m.Cmp(other) > 0
+}
+
+func (m Money) Sign() int {
+	return m.bigUnits().Sign()
+}
+
+func (m Money) IsZero() bool {
+	return m.Sign() == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Sign() < 0
+}
+
+func (m Money) Float64() float64 {
+	value, _ := new(big.Rat).SetFrac(m.bigUnits(), pow10(m.scale)).Float64()
+	return value
+}
+
+func (m Money) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(m.bigUnits(), pow10(m.scale))
+}
+
+func (m Money) String() string {
+	units := m.bigUnits()
+	digits := new(big.Int).Abs(units).String()
+	sign := ""
+
+	if units.Sign() < 0 {
+		sign = "-"
+	}
+
+	if m.scale == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= int(m.scale) {
+		digits = strings.Repeat("0", int(m.scale)-len(digits)+1) + digits
+	}
+
+	point := len(digits) - int(m.scale)
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	if bytes.Equal(data, []byte("null")) {
+		*m = Money{}
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		data = []byte(value)
+	}
+
+	parsed, err := ParseMoney(string(data))
+
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+func (m Money) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Money) UnmarshalText(text []byte) error {
+	parsed, err := ParseMoney(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+func (m Money) bigUnits() *big.Int {
+	if m.units == nil {
+		return new(big.Int)
+	}
+
+	return m.units
+}
+
+func align(a Money, b Money) (*big.Int, *big.Int, int32) {
+	x := new(big.Int).Set(a.bigUnits())
+	y := new(big.Int).Set(b.bigUnits())
+
+	if a.scale > b.scale {
+		y.Mul(y, pow10(a.scale-b.scale))
+		return x, y, a.scale
+	}
+
+	if b.scale > a.scale {
+		x.Mul(x, pow10(b.scale-a.scale))
+	}
+
+	return x, y, b.scale
+}
+
+func pow10(exponent int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
@@ -21,3 +21,68 @@ type PaginationResponse[T any] struct {
 	Items      []T        `json:"items"`
 	Pagination Pagination `json:"pagination"`
 }
+
+type PageFetcher[T any] func(page int) ([]T, Pagination, *HttpErrorResponse)
+
+type Pager[T any] struct {
+	fetch    PageFetcher[T]
+	page     int
+	fetched  int
+	maxPages int
+	items    []T
+	index    int
+	current  T
+	done     bool
+	err      *HttpErrorResponse
+}
+
+func NewPager[T any](page int, fetch PageFetcher[T]) *Pager[T] {
+	if page <= 0 {
+		page = 1
+	}
+
+	return &Pager[T]{fetch: fetch, page: page}
+}
+
+func (p *Pager[T]) WithMaxPages(pages int) *Pager[T] {
+	p.maxPages = pages
+	return p
+}
+
+func (p *Pager[T]) Next() bool {
+	for {
+		if p.index < len(p.items) {
+			p.current = p.items[p.index]
+			p.index++
+			return true
+		}
+
+		if p.done || p.err != nil {
+			return false
+		}
+
+		items, pagination, err := p.fetch(p.page)
+
+		if err != nil {
+			p.err = err
+			return false
+		}
+
+		p.items = items
+		p.index = 0
+		p.page++
+		p.fetched++
+
+		if len(items) == 0 || pagination.CurrentPage >= pagination.TotalPages || (p.maxPages > 0 && p.fetched >= p.maxPages) {
+			p.done = true
+		}
+	}
+}
+
+func (p *Pager[T]) Item() T {
+	return p.current
+}
+
+func (p *Pager[T]) Err() *HttpErrorResponse {
+	return p.err
+}
@@ -0,0 +1,53 @@
+package utils
+
+import "testing"
+
+func TestPagerWalksAllPages(t *testing.T) {
+	pages := [][]int{{1, 2}, {3}, {4, 5}}
+	requested := []int{}
+
+	pager := NewPager(0, func(page int) ([]int, Pagination, *HttpErrorResponse) {
+		requested = append(requested, page)
+		return pages[page-1], Pagination{CurrentPage: page, TotalPages: len(pages)}, nil
+	})
+
+	items := []int{}
+	for pager.Next() {
+		items = append(items, pager.Item())
+	}
+
+	if pager.Err() != nil || len(items) != 5 || items[4] != 5 || len(requested) != 3 {
+		t.Fatalf("items %v, pages %v, err %v", items, requested, pager.Err())
+	}
+}
+
+func TestPagerStopsOnErrorAndMaxPages(t *testing.T) {
+	failing := NewPager(1, func(page int) ([]int, Pagination, *HttpErrorResponse) {
+		if page == 2 {
+			return nil, Pagination{}, &HttpErrorResponse{Message: "boom"}
+		}
+		return []int{page}, Pagination{CurrentPage: page, TotalPages: 5}, nil
+	})
+
+	count := 0
+	for failing.Next() {
+		count++
+	}
+
+	if count != 1 || failing.Err() == nil {
+		t.Fatalf("count %d, err %v", count, failing.Err())
+	}
+
+	limited := NewPager(1, func(page int) ([]int, Pagination, *HttpErrorResponse) {
+		return []int{page}, Pagination{CurrentPage: page, TotalPages: 5}, nil
+	}).WithMaxPages(2)
+
+	count = 0
+	for limited.Next() {
+		count++
+	}
+
+	if count != 2 {
+		t.Fatalf("got %d items with max pages 2", count)
+	}
+}
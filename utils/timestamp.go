@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999 Z07:00",
+	"2006-01-02 15:04:05.999999999 -0700",
+	"2006-01-02 15:04:05.999999999 MST",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+type Timestamp struct {
+	time.Time
+	raw string
+}
+
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{Time: t}
+}
+
+func ParseTimestamp(value string) (Timestamp, error) {
+	return ParseTimestampIn(value, time.UTC)
+}
+
+func ParseTimestampIn(value string, location *time.Location) (Timestamp, error) {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		return Timestamp{}, nil
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && len(value) >= 9 {
+		if len(value) >= 13 {
+			return Timestamp{Time: time.UnixMilli(seconds).UTC(), raw: value}, nil
+		}
+		return Timestamp{Time: time.Unix(seconds, 0).UTC(), raw: value}, nil
+	}
+
+	var lastErr error
+
+	for _, layout := range timestampLayouts {
+		parsed, err := time.ParseInLocation(layout, value, location)
+
+		if err == nil {
+			return Timestamp{Time: parsed, raw: value}, nil
+		}
+
+		lastErr = err
+	}
+
+	return Timestamp{raw: value}, lastErr
+}
+
+func (t Timestamp) WithDefaultLocation(location *time.Location) Timestamp {
+	if t.raw == "" || location == nil {
+		return t
+	}
+
+	if parsed, err := ParseTimestampIn(t.raw, location); err == nil {
+		return parsed
+	}
+
+	return t
+}
+
+func (t Timestamp) Raw() string {
+	return t.raw
+}
+
+func (t Timestamp) String() string {
+	if t.raw != "" {
+		return t.raw
+	}
+
+	if t.Time.IsZero() {
+		return ""
+	}
+
+	return t.Time.Format(time.RFC3339)
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.raw == "" && t.Time.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(t.String())
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	if bytes.Equal(data, []byte("null")) {
+		*t = Timestamp{}
+		return nil
+	}
+
+	value := string(data)
+
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+	}
+
+	parsed, _ := ParseTimestamp(value)
+	*t = parsed
+
+	return nil
+}
+
+func (t Timestamp) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *Timestamp) UnmarshalText(text []byte) error {
+	parsed, _ := ParseTimestamp(string(text))
+	*t = parsed
+
+	return nil
+}
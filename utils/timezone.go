@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var utcOffsetRegex = regexp.MustCompile(`^(?i:UTC|GMT)?\s*([+-])(\d{1,2})(?::?(\d{2}))?$`)
+
+func ParseTimezone(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return nil, fmt.Errorf("timezone is empty")
+	}
+
+	if strings.EqualFold(name, "UTC") || strings.EqualFold(name, "GMT") || name == "Z" {
+		return time.UTC, nil
+	}
+
+	if location, err := time.LoadLocation(name); err == nil {
+		return location, nil
+	}
+
+	matches := utcOffsetRegex.FindStringSubmatch(name)
+
+	if matches == nil {
+		return nil, fmt.Errorf("unknown timezone %q", name)
+	}
+
+	hours, _ := strconv.Atoi(matches[2])
+	minutes := 0
+
+	if matches[3] != "" {
+		minutes, _ = strconv.Atoi(matches[3])
+	}
+
+	if hours > 14 || minutes > 59 {
+		return nil, fmt.Errorf("invalid timezone offset %q", name)
+	}
+
+	offset := hours*3600 + minutes*60
+	if matches[1] == "-" {
+		offset = -offset
+	}
+
+	return time.FixedZone(fmt.Sprintf("UTC%s%02d:%02d", matches[1], hours, minutes), offset), nil
+}
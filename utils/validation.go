@@ -1,9 +1,103 @@
 package utils
 
-import "github.com/go-playground/validator/v10"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var senderNumberRegex = regexp.MustCompile(`^\+?[1-9]\d{6,14}$`)
+var senderAlphanumericRegex = regexp.MustCompile(`^[A-Za-z0-9 .&_-]{1,11}$`)
+var senderLetterRegex = regexp.MustCompile(`[A-Za-z]`)
 
 func GetValidate() *validator.Validate {
 	validate := validator.New(validator.WithRequiredStructEnabled())
+	_ = validate.RegisterValidation("sender_id", isSenderId)
+	_ = validate.RegisterValidation("phone_number", isPhoneNumber)
+
+	return validate
+}
+
+func GetJsonValidate() *validator.Validate {
+	validate := GetValidate()
+	validate.RegisterTagNameFunc(jsonTagName)
 
 	return validate
 }
+
+func ValidationErrorResponse(err error) *HttpErrorResponse {
+	var validationErrors validator.ValidationErrors
+
+	if !errors.As(err, &validationErrors) {
+		return &HttpErrorResponse{Success: false, Message: err.Error()}
+	}
+
+	fields := map[string]string{}
+
+	for _, fieldError := range validationErrors {
+		fields[fieldPath(fieldError.Namespace())] = describeFieldError(fieldError)
+	}
+
+	return &HttpErrorResponse{Success: false, Message: "Validation failed", Errors: &fields}
+}
+
+func IsSenderId(value string) bool {
+	if senderNumberRegex.MatchString(value) {
+		return true
+	}
+
+	return senderAlphanumericRegex.MatchString(value) && senderLetterRegex.MatchString(value) && strings.TrimSpace(value) == value
+}
+
+func isPhoneNumber(fl validator.FieldLevel) bool {
+	return senderNumberRegex.MatchString(fl.Field().String())
+}
+
+func isSenderId(fl validator.FieldLevel) bool {
+	return IsSenderId(fl.Field().String())
+}
+
+func jsonTagName(field reflect.StructField) string {
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+
+	if name == "-" {
+		return ""
+	}
+
+	return name
+}
+
+func fieldPath(namespace string) string {
+	if index := strings.Index(namespace, "."); index >= 0 {
+		return namespace[index+1:]
+	}
+
+	return namespace
+}
+
+func describeFieldError(fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return "is required"
+	case "required_without":
+		return fmt.Sprintf("is required when %s is empty", fieldError.Param())
+	case "e164", "phone_number":
+		return "must be a phone number in E.164 format"
+	case "sender_id":
+		return "must be an E.164 phone number or an alphanumeric sender ID of up to 11 characters"
+	case "url", "http_url":
+		return "must be a valid URL"
+	case "min":
+		return fmt.Sprintf("must be at least %s", fieldError.Param())
+	case "max":
+		return fmt.Sprintf("must be at most %s", fieldError.Param())
+	case "oneof":
+		return fmt.Sprintf("must be one of: %s", fieldError.Param())
+	}
+
+	return fmt.Sprintf("failed on the '%s' validation", fieldError.Tag())
+}
@@ -17,11 +17,11 @@ type VoiceCampaignPayload struct {
 }
 
 type VoiceCampaignItem struct {
-	Id        int    `json:"id"`
-	Status    string `json:"status"`
-	Timestamp string `json:"timestamp"`
-	CallerId  string `json:"caller_id"`
-	Contact   string `json:"contact"`
+	Id        int             `json:"id"`
+	Status    string          `json:"status"`
+	Timestamp utils.Timestamp `json:"timestamp"`
+	CallerId  string          `json:"caller_id"`
+	Contact   string          `json:"contact"`
 }
 
 type TriggerScenarioPayload struct {
@@ -33,6 +33,10 @@ type TriggerScenarioResponse struct {
 }
 
 func (s *VoiceCampaignService) TriggerScenario(payload TriggerScenarioPayload) (*TriggerScenarioResponse, *utils.HttpErrorResponse) {
+	if httpError := normalizePhoneFields(map[string]*string{"contact": &payload.VoiceCampaign.Contact}); httpError != nil {
+		return nil, httpError
+	}
+
 	validate := utils.GetValidate()
 	err := validate.Struct(payload)
 